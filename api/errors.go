@@ -0,0 +1,61 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	ethiopiancalendar "github.com/mel-ak/ethiopiancalendar/pkg"
+)
+
+// amharicErrors translates the package's sentinel errors to Amharic.
+var amharicErrors = []struct {
+	err error
+	msg string
+}{
+	{ethiopiancalendar.ErrInvalidYear, "ዓመቱ አዎንታዊ ቁጥር መሆን አለበት"},
+	{ethiopiancalendar.ErrInvalidMonth, "ወሩ ከ1 እስከ 13 መሆን አለበት"},
+	{ethiopiancalendar.ErrInvalidDay, "ቀኑ ለወሩ ከሚፈቀደው ክልል ውጭ ነው"},
+	{ethiopiancalendar.ErrInvalidOrdinal, "የዓመቱ ቀን ቁጥር ከክልል ውጭ ነው"},
+	{ethiopiancalendar.ErrBeforeEpoch, "ቀኑ ከኢትዮጵያ የዘመን አቆጣጠር መነሻ በፊት ነው"},
+	{ethiopiancalendar.ErrInvalidGregorianYear, "ትክክለኛ ያልሆነ የግሪጎሪያን ዓመት"},
+	{ethiopiancalendar.ErrInvalidGregorianMonth, "የግሪጎሪያን ወር ከ1 እስከ 12 መሆን አለበት"},
+	{ethiopiancalendar.ErrInvalidGregorianDay, "የግሪጎሪያን ቀን ለወሩ ከሚፈቀደው ክልል ውጭ ነው"},
+	{ethiopiancalendar.ErrInvalidRange, "የማብቂያው ቀን ከመነሻው ቀን በፊት ነው"},
+}
+
+// preferredLanguage returns "am" when the request's Accept-Language header
+// prefers Amharic and "en" otherwise.
+func preferredLanguage(r *http.Request) string {
+	tags := strings.Split(r.Header.Get("Accept-Language"), ",")
+	tag, _, _ := strings.Cut(strings.TrimSpace(tags[0]), ";")
+	primary, _, _ := strings.Cut(strings.ToLower(tag), "-")
+	if primary == "am" {
+		return "am"
+	}
+	return "en"
+}
+
+// requestLocale returns the locale for localized response fields: the
+// "locale" query parameter when it is "am" or "en", and otherwise the
+// language preferred by the Accept-Language header.
+func requestLocale(r *http.Request) string {
+	switch locale := r.URL.Query().Get("locale"); locale {
+	case ethiopiancalendar.LocaleAmharic, ethiopiancalendar.LocaleEnglish:
+		return locale
+	}
+	return preferredLanguage(r)
+}
+
+// localizeError returns the message for err in the request's preferred
+// language, falling back to the English error text.
+func localizeError(r *http.Request, err error) string {
+	if preferredLanguage(r) == "am" {
+		for _, e := range amharicErrors {
+			if errors.Is(err, e.err) {
+				return e.msg
+			}
+		}
+	}
+	return err.Error()
+}
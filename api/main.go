@@ -1,23 +1,91 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
 	"net/http"
-	"path/filepath"
+	"os"
+	"runtime/debug"
+	"strconv"
+	"strings"
 	"time"
 
 	ethiopiancalendar "github.com/mel-ak/ethiopiancalendar/pkg"
+	"github.com/mel-ak/ethiopiancalendar/public"
 )
 
 // APIRequest structs for JSON payloads
 type ConvertRequest struct {
 	Type  string `json:"type"` // "etToGreg" or "gregToEt"
+	Date  string `json:"date"` // "YYYY-MM-DD"; takes precedence over year, month and day
 	Year  int    `json:"year"`
 	Month int    `json:"month"`
 	Day   int    `json:"day"`
 }
 
+// convert performs the conversion described by req, with Ethiopian month
+// names in the request's locale.
+func convert(r *http.Request, req ConvertRequest) (ConvertResponse, error) {
+	year, month, day, err := req.fields()
+	if err != nil {
+		return ConvertResponse{}, err
+	}
+	switch req.Type {
+	case "etToGreg":
+		gy, gm, gd, err := ethiopiancalendar.EtDate{Year: year, Month: month, Day: day}.ToGregorian()
+		if err != nil {
+			return ConvertResponse{}, err
+		}
+		return ConvertResponse{Year: gy, Month: gm, Day: gd}, nil
+	case "gregToEt":
+		date, err := ethiopiancalendar.FromGregorian(year, month, day)
+		if err != nil {
+			return ConvertResponse{}, err
+		}
+		return ConvertResponse{
+			Year:      date.Year,
+			Month:     date.Month,
+			Day:       date.Day,
+			MonthName: date.FormatLocale("Month", requestLocale(r)),
+		}, nil
+	}
+	return ConvertResponse{}, errors.New("Invalid conversion type")
+}
+
+// fields returns the date to convert, from Date when it is set and from
+// Year, Month and Day otherwise. Date is parsed as an Ethiopian date for
+// etToGreg, which also validates it, and as a Gregorian date otherwise; the
+// separate fields are checked by the conversion.
+func (req ConvertRequest) fields() (year, month, day int, err error) {
+	if req.Date == "" {
+		return req.Year, req.Month, req.Day, nil
+	}
+	if req.Type == "etToGreg" {
+		date, err := ethiopiancalendar.Parse("YYYY-MM-DD", req.Date)
+		if verr := (*ethiopiancalendar.ValidationError)(nil); errors.As(err, &verr) {
+			return 0, 0, 0, err
+		}
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("invalid date %q: expected YYYY-MM-DD", req.Date)
+		}
+		return date.Year, date.Month, date.Day, nil
+	}
+	t, err := time.Parse(time.DateOnly, req.Date)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("invalid date %q: expected YYYY-MM-DD", req.Date)
+	}
+	return t.Year(), int(t.Month()), t.Day(), nil
+}
+
+// TimestampRequest is the body of the timestamp conversion endpoint.
+type TimestampRequest struct {
+	Timestamp string `json:"timestamp"` // RFC 3339, e.g. "2023-09-12T08:30:00+03:00"
+}
+
 type FormatRequest struct {
 	Year   int    `json:"year"`
 	Month  int    `json:"month"`
@@ -33,30 +101,278 @@ type ArithmeticRequest struct {
 	Value     int    `json:"value"`
 }
 
+// BothRequest is the Ethiopian date sent to the both endpoint.
+type BothRequest struct {
+	Year  int `json:"year"`
+	Month int `json:"month"`
+	Day   int `json:"day"`
+}
+
 type LeapRequest struct {
 	Year  int `json:"year"`
 	Month int `json:"month"`
 }
 
-// APIResponse for all endpoints
-type APIResponse struct {
-	Year        int    `json:"year,omitempty"`
-	Month       int    `json:"month,omitempty"`
-	Day         int    `json:"day,omitempty"`
-	Result      string `json:"result,omitempty"`
-	IsLeap      bool   `json:"isLeap,omitempty"`
-	DaysInMonth *int   `json:"daysInMonth,omitempty"`
-	Error       string `json:"error,omitempty"`
+// ConvertResponse is the date returned by the convert endpoint, in the
+// target calendar. MonthName is only set for Ethiopian results, in the
+// request's locale.
+type ConvertResponse struct {
+	Year      int    `json:"year"`
+	Month     int    `json:"month"`
+	Day       int    `json:"day"`
+	MonthName string `json:"monthName,omitempty"`
+}
+
+// DateResponse is an Ethiopian date returned by the arithmetic and current
+// endpoints.
+type DateResponse struct {
+	Year  int `json:"year"`
+	Month int `json:"month"`
+	Day   int `json:"day"`
+}
+
+// TimestampResponse is an Ethiopian date and time returned by the timestamp
+// conversion endpoint, in the offset of the request's timestamp.
+type TimestampResponse struct {
+	Year       int    `json:"year"`
+	Month      int    `json:"month"`
+	Day        int    `json:"day"`
+	Hour       int    `json:"hour"`
+	Minute     int    `json:"minute"`
+	Second     int    `json:"second"`
+	Nanosecond int    `json:"nanosecond"`
+	Offset     string `json:"offset"` // e.g. "+03:00"
+}
+
+// FormatResponse is returned by the format endpoint.
+type FormatResponse struct {
+	Result string `json:"result"`
+}
+
+// LeapResponse is returned by the leap endpoint. DaysInMonth is only set
+// when a month was requested.
+type LeapResponse struct {
+	IsLeap      bool `json:"isLeap"`
+	DaysInMonth *int `json:"daysInMonth,omitempty"`
+}
+
+// HealthResponse is returned by the health-check endpoints.
+type HealthResponse struct {
+	Status string `json:"status"`
 }
 
+// LeapYear is one entry of the leap range endpoint's response.
+type LeapYear struct {
+	Year   int  `json:"year"`
+	IsLeap bool `json:"isLeap"`
+}
+
+// maxLeapRange bounds the number of years a single leap range request may span.
+const maxLeapRange = 1000
+
+// ErrorResponse is returned by every endpoint on failure.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// MonthResponse describes every day of an Ethiopian month.
+type MonthResponse struct {
+	Year         int        `json:"year"`
+	Month        int        `json:"month"`
+	MonthName    string     `json:"monthName"`
+	DaysInMonth  int        `json:"daysInMonth"`
+	FirstWeekday int        `json:"firstWeekday"` // 0 (Ehud/Sunday) to 6 (Kidame/Saturday)
+	Days         []MonthDay `json:"days"`
+}
+
+// MonthDay is a single day in a MonthResponse.
+type MonthDay struct {
+	Day       int           `json:"day"`
+	Weekday   int           `json:"weekday"`
+	Gregorian GregorianDate `json:"gregorian"`
+	IsHoliday bool          `json:"isHoliday"`
+}
+
+// BothResponse is returned by the both endpoint: an Ethiopian date with its
+// Gregorian equivalent and the shared weekday. Names are in the request's
+// locale.
+type BothResponse struct {
+	Ethiopian   EthiopianDate `json:"ethiopian"`
+	Gregorian   GregorianDate `json:"gregorian"`
+	Weekday     int           `json:"weekday"` // 0 (Ehud/Sunday) to 6 (Kidame/Saturday)
+	WeekdayName string        `json:"weekdayName"`
+}
+
+// EthiopianDate is the JSON form of an Ethiopian date with its month name.
+type EthiopianDate struct {
+	Year      int    `json:"year"`
+	Month     int    `json:"month"`
+	Day       int    `json:"day"`
+	MonthName string `json:"monthName"`
+}
+
+// GregorianDate is the JSON form of a Gregorian date.
+type GregorianDate struct {
+	Year  int `json:"year"`
+	Month int `json:"month"`
+	Day   int `json:"day"`
+}
+
+// addisAbaba is the time zone "today" is computed in. Ethiopia observes no
+// daylight saving time, so a fixed UTC+3 zone stands in when the tz database
+// is unavailable.
+var addisAbaba = loadLocation("Africa/Addis_Ababa", time.FixedZone("EAT", 3*60*60))
+
+func loadLocation(name string, fallback *time.Location) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return fallback
+	}
+	return loc
+}
+
+// holidaysFileEnv names the environment variable holding the path of an
+// optional JSON holiday list to load at startup; see
+// ethiopiancalendar.LoadHolidays for its format.
+const holidaysFileEnv = "ETHIOPIANCALENDAR_HOLIDAYS_FILE"
+
 func main() {
-	// Serve static index.html
-	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
-		http.ServeFile(w, r, filepath.Join(".", "public/index.html"))
+	if path := os.Getenv(holidaysFileEnv); path != "" {
+		if err := loadHolidaysFile(path); err != nil {
+			log.Fatalf("loading holidays from %s: %v", path, err)
+		}
+	}
+	fmt.Println("Server starting at http://localhost:8080")
+	http.ListenAndServe(":8080", recoverPanics(newMux()))
+}
+
+// loadHolidaysFile registers the holidays listed in the JSON file at path.
+func loadHolidaysFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return ethiopiancalendar.LoadHolidays(f)
+}
+
+// maxStreamLine bounds the lines /api/convert/stream reads: a line of
+// maxStreamLine bytes or more is skipped and reported inline like other bad
+// lines.
+const maxStreamLine = 64 << 10
+
+// scanStreamLines returns a bufio.SplitFunc that splits input into lines as
+// bufio.ScanLines does, except that a line of limit bytes or more does not
+// end the scan: it is discarded, *tooLong is set and an empty token is
+// returned in its place. The scanner's buffer must hold limit bytes.
+func scanStreamLines(limit int, tooLong *bool) bufio.SplitFunc {
+	skipping := false
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		i := bytes.IndexByte(data, '\n')
+		switch {
+		case skipping && i < 0:
+			return len(data), nil, nil
+		case skipping:
+			skipping = false
+			return i + 1, nil, nil
+		case i >= 0:
+			return i + 1, data[:i], nil
+		case atEOF && len(data) > 0:
+			return len(data), data, nil
+		case len(data) >= limit:
+			skipping = true
+			*tooLong = true
+			return len(data), data[:0], nil
+		}
+		return 0, nil, nil
+	}
+}
+
+// recoverPanics turns a panic in next into a logged 500 response with a
+// generic JSON error instead of a dropped connection. If next has already
+// started its response, the panic is only logged, since the status can no
+// longer change. http.ErrAbortHandler is re-panicked so the server still
+// aborts the response as the handler asked.
+func recoverPanics(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tw := &trackingWriter{ResponseWriter: w}
+		defer func() {
+			err := recover()
+			if err == nil {
+				return
+			}
+			if err == http.ErrAbortHandler {
+				panic(err)
+			}
+			log.Printf("panic serving %s %s: %v\n%s", r.Method, r.URL.Path, err, debug.Stack())
+			if !tw.wrote {
+				sendErrorStatus(w, http.StatusInternalServerError, "Internal server error")
+			}
+		}()
+		next.ServeHTTP(tw, r)
+	})
+}
+
+// trackingWriter records whether a handler has started its response.
+type trackingWriter struct {
+	http.ResponseWriter
+	wrote bool
+}
+
+func (w *trackingWriter) WriteHeader(status int) {
+	w.wrote = true
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *trackingWriter) Write(b []byte) (int, error) {
+	w.wrote = true
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush sends the headers as well, so it starts the response too.
+func (w *trackingWriter) Flush() {
+	w.wrote = true
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (w *trackingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// newMux registers all routes on a new ServeMux.
+func newMux() *http.ServeMux {
+	mux := http.NewServeMux()
+
+	// Liveness and readiness probes; they do no date computation
+	health := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		sendJSON(w, HealthResponse{Status: "ok"})
+	}
+	mux.HandleFunc("/healthz", health)
+	mux.HandleFunc("/readyz", health)
+
+	// Serve the embedded index.html at the root only; "/" also catches
+	// every unregistered path, which must not get the page
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			if strings.HasPrefix(r.URL.Path, "/api/") {
+				sendErrorStatus(w, http.StatusNotFound, "Not found")
+				return
+			}
+			http.NotFound(w, r)
+			return
+		}
+		http.ServeContent(w, r, "index.html", time.Time{}, bytes.NewReader(public.IndexHTML))
 	})
 
 	// Conversion endpoint
-	http.HandleFunc("/api/convert", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/api/convert", func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
@@ -66,35 +382,130 @@ func main() {
 			sendError(w, "Invalid JSON")
 			return
 		}
-		var resp APIResponse
-		if req.Type == "etToGreg" {
-			date := ethiopiancalendar.EtDate{Year: req.Year, Month: req.Month, Day: req.Day}
-			if err := date.Validate(); err != nil {
-				sendError(w, err.Error())
-				return
+		resp, err := convert(r, req)
+		if err != nil {
+			sendError(w, localizeError(r, err))
+			return
+		}
+		sendJSON(w, resp)
+	})
+
+	// Streaming conversion: one ConvertRequest per line in, one
+	// ConvertResponse or ErrorResponse per line out. A bad line gets an
+	// inline error and the stream carries on.
+	mux.HandleFunc("/api/convert/stream", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		rc := http.NewResponseController(w)
+		enc := json.NewEncoder(w)
+		scanner := bufio.NewScanner(r.Body)
+		var tooLong bool
+		scanner.Buffer(make([]byte, 0, 4096), maxStreamLine)
+		scanner.Split(scanStreamLines(maxStreamLine, &tooLong))
+		for scanner.Scan() {
+			line := bytes.TrimSpace(scanner.Bytes())
+			if len(line) == 0 && !tooLong {
+				continue
 			}
-			gy, gm, gd, err := date.ToGregorian()
-			if err != nil {
-				sendError(w, err.Error())
-				return
+			var result any
+			var req ConvertRequest
+			if tooLong {
+				tooLong = false
+				result = ErrorResponse{Error: fmt.Sprintf("Line too long: lines must be shorter than %d bytes", maxStreamLine)}
+			} else if err := json.Unmarshal(line, &req); err != nil {
+				result = ErrorResponse{Error: "Invalid JSON"}
+			} else if resp, err := convert(r, req); err != nil {
+				result = ErrorResponse{Error: localizeError(r, err)}
+			} else {
+				result = resp
 			}
-			resp = APIResponse{Year: gy, Month: gm, Day: gd}
-		} else if req.Type == "gregToEt" {
-			date, err := ethiopiancalendar.FromGregorian(req.Year, req.Month, req.Day)
-			if err != nil {
-				sendError(w, err.Error())
+			if err := enc.Encode(result); err != nil {
+				// The client has gone away.
 				return
 			}
-			resp = APIResponse{Year: date.Year, Month: date.Month, Day: date.Day}
-		} else {
-			sendError(w, "Invalid conversion type")
+			rc.Flush()
+		}
+		if err := scanner.Err(); err != nil {
+			enc.Encode(ErrorResponse{Error: "Invalid input: " + err.Error()})
+		}
+	})
+
+	// Both calendars at once, for dual-calendar displays
+	mux.HandleFunc("/api/both", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
-		sendJSON(w, resp)
+		var req BothRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			sendError(w, "Invalid JSON")
+			return
+		}
+		date := ethiopiancalendar.EtDate{Year: req.Year, Month: req.Month, Day: req.Day}
+		gy, gm, gd, err := date.ToGregorian()
+		if err != nil {
+			sendError(w, localizeError(r, err))
+			return
+		}
+		weekday, err := date.GregorianWeekday()
+		if err != nil {
+			sendError(w, localizeError(r, err))
+			return
+		}
+		locale := requestLocale(r)
+		weekdayName, err := date.WeekdayNameLocale(locale)
+		if err != nil {
+			sendError(w, localizeError(r, err))
+			return
+		}
+		sendJSON(w, BothResponse{
+			Ethiopian: EthiopianDate{
+				Year:      date.Year,
+				Month:     date.Month,
+				Day:       date.Day,
+				MonthName: date.FormatLocale("Month", locale),
+			},
+			Gregorian:   GregorianDate{Year: gy, Month: gm, Day: gd},
+			Weekday:     int(weekday),
+			WeekdayName: weekdayName,
+		})
+	})
+
+	// Timestamp conversion endpoint
+	mux.HandleFunc("/api/convert/timestamp", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var req TimestampRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			sendError(w, "Invalid JSON")
+			return
+		}
+		dt, err := ethiopiancalendar.FromRFC3339(req.Timestamp)
+		if err != nil {
+			sendError(w, localizeError(r, err))
+			return
+		}
+		// FromRFC3339 succeeded, so the date is valid.
+		instant, _ := dt.Time()
+		sendJSON(w, TimestampResponse{
+			Year:       dt.Date.Year,
+			Month:      dt.Date.Month,
+			Day:        dt.Date.Day,
+			Hour:       dt.Hour,
+			Minute:     dt.Minute,
+			Second:     dt.Second,
+			Nanosecond: dt.Nanosecond,
+			Offset:     instant.Format("-07:00"),
+		})
 	})
 
 	// Format endpoint
-	http.HandleFunc("/api/format", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/api/format", func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
@@ -106,15 +517,15 @@ func main() {
 		}
 		date := ethiopiancalendar.EtDate{Year: req.Year, Month: req.Month, Day: req.Day}
 		if err := date.Validate(); err != nil {
-			sendError(w, err.Error())
+			sendError(w, localizeError(r, err))
 			return
 		}
 		result := date.Format(req.Layout)
-		sendJSON(w, APIResponse{Result: result})
+		sendJSON(w, FormatResponse{Result: result})
 	})
 
 	// Arithmetic endpoint
-	http.HandleFunc("/api/arithmetic", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/api/arithmetic", func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
@@ -126,7 +537,7 @@ func main() {
 		}
 		date := ethiopiancalendar.EtDate{Year: req.Year, Month: req.Month, Day: req.Day}
 		if err := date.Validate(); err != nil {
-			sendError(w, err.Error())
+			sendError(w, localizeError(r, err))
 			return
 		}
 		var newDate ethiopiancalendar.EtDate
@@ -143,14 +554,14 @@ func main() {
 			return
 		}
 		if err != nil {
-			sendError(w, err.Error())
+			sendError(w, localizeError(r, err))
 			return
 		}
-		sendJSON(w, APIResponse{Year: newDate.Year, Month: newDate.Month, Day: newDate.Day})
+		sendJSON(w, DateResponse{Year: newDate.Year, Month: newDate.Month, Day: newDate.Day})
 	})
 
 	// Leap year and days in month endpoint
-	http.HandleFunc("/api/leap", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/api/leap", func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
@@ -164,7 +575,7 @@ func main() {
 			sendError(w, "Year must be positive")
 			return
 		}
-		resp := APIResponse{IsLeap: ethiopiancalendar.IsLeap(req.Year)}
+		resp := LeapResponse{IsLeap: ethiopiancalendar.IsLeap(req.Year)}
 		if req.Month != 0 {
 			days := ethiopiancalendar.DaysInMonth(req.Year, req.Month)
 			if days == 0 {
@@ -176,31 +587,114 @@ func main() {
 		sendJSON(w, resp)
 	})
 
+	// Leap years over a range of years
+	mux.HandleFunc("/api/leap/range", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		start, err := strconv.Atoi(r.URL.Query().Get("start"))
+		if err != nil {
+			sendErrorStatus(w, http.StatusBadRequest, "Invalid start year")
+			return
+		}
+		end, err := strconv.Atoi(r.URL.Query().Get("end"))
+		if err != nil {
+			sendErrorStatus(w, http.StatusBadRequest, "Invalid end year")
+			return
+		}
+		if start <= 0 {
+			sendErrorStatus(w, http.StatusBadRequest, "Year must be positive")
+			return
+		}
+		if start > end {
+			sendErrorStatus(w, http.StatusBadRequest, "Start year must not be after end year")
+			return
+		}
+		if end-start >= maxLeapRange {
+			sendErrorStatus(w, http.StatusBadRequest, fmt.Sprintf("Range must not exceed %d years", maxLeapRange))
+			return
+		}
+		years := make([]LeapYear, 0, end-start+1)
+		for year := start; year <= end; year++ {
+			years = append(years, LeapYear{Year: year, IsLeap: ethiopiancalendar.IsLeap(year)})
+		}
+		sendJSON(w, years)
+	})
+
 	// Current Ethiopian Date (optional, for future expansion)
-	http.HandleFunc("/api/current", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/api/current", func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
-		now := time.Now()
-		et, err := ethiopiancalendar.FromGregorian(now.Year(), int(now.Month()), now.Day())
+		et := ethiopiancalendar.Today(addisAbaba)
+		sendJSON(w, DateResponse{Year: et.Year, Month: et.Month, Day: et.Day})
+	})
+
+	// Month structure endpoint
+	mux.HandleFunc("/api/month", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		year, err := strconv.Atoi(r.URL.Query().Get("year"))
 		if err != nil {
-			sendError(w, err.Error())
+			sendErrorStatus(w, http.StatusBadRequest, "Invalid year")
+			return
+		}
+		month, err := strconv.Atoi(r.URL.Query().Get("month"))
+		if err != nil {
+			sendErrorStatus(w, http.StatusBadRequest, "Invalid month")
+			return
+		}
+		first := ethiopiancalendar.EtDate{Year: year, Month: month, Day: 1}
+		if err := first.Validate(); err != nil {
+			sendErrorStatus(w, http.StatusBadRequest, localizeError(r, err))
 			return
 		}
-		sendJSON(w, APIResponse{Year: et.Year, Month: et.Month, Day: et.Day})
+		resp := MonthResponse{
+			Year:        year,
+			Month:       month,
+			MonthName:   first.Format("Month"),
+			DaysInMonth: ethiopiancalendar.DaysInMonth(year, month),
+		}
+		for day := 1; day <= resp.DaysInMonth; day++ {
+			date := first.WithDay(day)
+			gy, gm, gd, err := date.ToGregorian()
+			if err != nil {
+				sendErrorStatus(w, http.StatusBadRequest, localizeError(r, err))
+				return
+			}
+			// The date converted, so it is valid and these cannot fail.
+			weekday, _ := date.Weekday()
+			holiday, _ := date.IsHoliday()
+			resp.Days = append(resp.Days, MonthDay{
+				Day:       day,
+				Weekday:   weekday,
+				Gregorian: GregorianDate{Year: gy, Month: gm, Day: gd},
+				IsHoliday: holiday,
+			})
+		}
+		resp.FirstWeekday = resp.Days[0].Weekday
+		sendJSON(w, resp)
 	})
 
-	fmt.Println("Server starting at http://localhost:8080")
-	http.ListenAndServe(":8080", nil)
+	return mux
 }
 
 // Helper functions
 func sendError(w http.ResponseWriter, msg string) {
-	sendJSON(w, APIResponse{Error: msg})
+	sendJSON(w, ErrorResponse{Error: msg})
+}
+
+func sendErrorStatus(w http.ResponseWriter, status int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(ErrorResponse{Error: msg})
 }
 
-func sendJSON(w http.ResponseWriter, resp APIResponse) {
+func sendJSON(w http.ResponseWriter, resp any) {
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(resp)
 }
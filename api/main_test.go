@@ -0,0 +1,528 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	ethiopiancalendar "github.com/mel-ak/ethiopiancalendar/pkg"
+)
+
+func TestMonthEndpoint(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/month?year=2016&month=1", nil)
+	rec := httptest.NewRecorder()
+	newMux().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", rec.Code)
+	}
+	var resp MonthResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.MonthName != "Meskerem" || resp.DaysInMonth != 30 || len(resp.Days) != 30 {
+		t.Errorf("Expected 30 days of Meskerem, got %q with %d/%d days", resp.MonthName, resp.DaysInMonth, len(resp.Days))
+	}
+	// Meskerem 1, 2016 is Tuesday, 12 September 2023
+	if resp.FirstWeekday != 2 {
+		t.Errorf("Expected first weekday 2, got %d", resp.FirstWeekday)
+	}
+	if g := resp.Days[0].Gregorian; g != (GregorianDate{Year: 2023, Month: 9, Day: 12}) {
+		t.Errorf("Expected first day 2023-09-12, got %+v", g)
+	}
+	// Enkutatash (Meskerem 1) and Meskel (Meskerem 17) are the holidays.
+	for _, d := range resp.Days {
+		if want := d.Day == 1 || d.Day == 17; d.IsHoliday != want {
+			t.Errorf("Day %d: isHoliday = %v, want %v", d.Day, d.IsHoliday, want)
+		}
+	}
+}
+
+func TestMonthEndpointPagume(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/month?year=2015&month=13", nil)
+	rec := httptest.NewRecorder()
+	newMux().ServeHTTP(rec, req)
+
+	var resp MonthResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.DaysInMonth != 6 || len(resp.Days) != 6 {
+		t.Errorf("Expected 6 days in Pagume 2015, got %d/%d", resp.DaysInMonth, len(resp.Days))
+	}
+}
+
+func TestMonthEndpointBadInput(t *testing.T) {
+	for _, query := range []string{"year=2016", "year=abc&month=1", "year=2016&month=14", "year=0&month=1"} {
+		req := httptest.NewRequest(http.MethodGet, "/api/month?"+query, nil)
+		rec := httptest.NewRecorder()
+		newMux().ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status 400, got %d", query, rec.Code)
+		}
+	}
+}
+
+func TestLocalizedErrors(t *testing.T) {
+	tests := []struct {
+		lang string
+		want string
+	}{
+		{"am", "ወሩ ከ1 እስከ 13 መሆን አለበት"},
+		{"am-ET,en;q=0.8", "ወሩ ከ1 እስከ 13 መሆን አለበት"},
+		{"en-US", "month must be between 1 and 13"},
+		{"", "month must be between 1 and 13"},
+	}
+
+	for _, tt := range tests {
+		body := strings.NewReader(`{"type":"etToGreg","year":2016,"month":14,"day":1}`)
+		req := httptest.NewRequest(http.MethodPost, "/api/convert", body)
+		req.Header.Set("Accept-Language", tt.lang)
+		rec := httptest.NewRecorder()
+		newMux().ServeHTTP(rec, req)
+
+		var resp ErrorResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatal(err)
+		}
+		if resp.Error != tt.want {
+			t.Errorf("Accept-Language %q: got error %q, want %q", tt.lang, resp.Error, tt.want)
+		}
+	}
+}
+
+func TestConvertMonthNameLocale(t *testing.T) {
+	tests := []struct {
+		query, lang, want string
+	}{
+		{"", "", "Meskerem"},
+		{"", "am-ET,en;q=0.8", "መስከረም"},
+		{"?locale=am", "", "መስከረም"},
+		{"?locale=en", "am", "Meskerem"},
+		{"?locale=fr", "am", "መስከረም"},
+	}
+
+	for _, tt := range tests {
+		body := strings.NewReader(`{"type":"gregToEt","year":2023,"month":9,"day":12}`)
+		req := httptest.NewRequest(http.MethodPost, "/api/convert"+tt.query, body)
+		req.Header.Set("Accept-Language", tt.lang)
+		rec := httptest.NewRecorder()
+		newMux().ServeHTTP(rec, req)
+
+		var resp ConvertResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatal(err)
+		}
+		if resp.MonthName != tt.want {
+			t.Errorf("Query %q, Accept-Language %q: got monthName %q, want %q", tt.query, tt.lang, resp.MonthName, tt.want)
+		}
+	}
+}
+
+func TestLocalizedWrappedError(t *testing.T) {
+	body := strings.NewReader(`{"type":"gregToEt","year":2023,"month":2,"day":30}`)
+	req := httptest.NewRequest(http.MethodPost, "/api/convert", body)
+	req.Header.Set("Accept-Language", "am")
+	rec := httptest.NewRecorder()
+	newMux().ServeHTTP(rec, req)
+
+	var resp ErrorResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Error != "የግሪጎሪያን ቀን ለወሩ ከሚፈቀደው ክልል ውጭ ነው" {
+		t.Errorf("Got error %q", resp.Error)
+	}
+}
+
+func TestRecoverPanics(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	handler := recoverPanics(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+	req := httptest.NewRequest(http.MethodGet, "/api/anything", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("Expected status 500, got %d", rec.Code)
+	}
+	var resp ErrorResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Error != "Internal server error" {
+		t.Errorf("Expected generic error, got %q", resp.Error)
+	}
+}
+
+func TestRecoverPanicsAfterWrite(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	handler := recoverPanics(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		io.WriteString(w, "partial")
+		panic("boom")
+	}))
+	req := httptest.NewRequest(http.MethodGet, "/api/anything", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusAccepted {
+		t.Errorf("Expected the handler's status 202, got %d", rec.Code)
+	}
+	if got := rec.Body.String(); got != "partial" {
+		t.Errorf("Expected the body to end where the handler stopped, got %q", got)
+	}
+}
+
+func TestRecoverPanicsAbortHandler(t *testing.T) {
+	handler := recoverPanics(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic(http.ErrAbortHandler)
+	}))
+	req := httptest.NewRequest(http.MethodGet, "/api/anything", nil)
+	rec := httptest.NewRecorder()
+	defer func() {
+		if err := recover(); err != http.ErrAbortHandler {
+			t.Errorf("Expected http.ErrAbortHandler to propagate, recovered %v", err)
+		}
+		if rec.Body.Len() != 0 {
+			t.Errorf("Expected no error body, got %q", rec.Body.String())
+		}
+	}()
+	handler.ServeHTTP(rec, req)
+}
+
+// postJSON sends body to path on a new mux and decodes the response into a
+// map so tests can check which fields are present.
+func postJSON(t *testing.T, path, body string) map[string]any {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	newMux().ServeHTTP(rec, req)
+
+	var fields map[string]any
+	if err := json.NewDecoder(rec.Body).Decode(&fields); err != nil {
+		t.Fatal(err)
+	}
+	return fields
+}
+
+func TestResponseFields(t *testing.T) {
+	tests := []struct {
+		path, body string
+		want       map[string]any
+	}{
+		{"/api/convert", `{"type":"etToGreg","year":2016,"month":1,"day":1}`, map[string]any{"year": 2023.0, "month": 9.0, "day": 12.0}},
+		{"/api/convert", `{"type":"gregToEt","year":2023,"month":9,"day":12}`, map[string]any{"year": 2016.0, "month": 1.0, "day": 1.0, "monthName": "Meskerem"}},
+		{"/api/convert", `{"type":"etToGreg","date":"2016-01-01"}`, map[string]any{"year": 2023.0, "month": 9.0, "day": 12.0}},
+		{"/api/convert", `{"type":"gregToEt","date":"2023-09-12","year":2000,"month":1,"day":1}`, map[string]any{"year": 2016.0, "month": 1.0, "day": 1.0, "monthName": "Meskerem"}},
+		{"/api/convert", `{"type":"etToGreg","date":"2016/01/01"}`, map[string]any{"error": `invalid date "2016/01/01": expected YYYY-MM-DD`}},
+		{"/api/convert", `{"type":"etToGreg","date":"2016-13-06"}`, map[string]any{"error": "day out of range for month"}},
+		{"/api/convert", `{"type":"etToGreg","date":"2016-+1-01"}`, map[string]any{"error": `invalid date "2016-+1-01": expected YYYY-MM-DD`}},
+		{"/api/convert", `{"type":"gregToEt","date":"2023-+9-12"}`, map[string]any{"error": `invalid date "2023-+9-12": expected YYYY-MM-DD`}},
+		{"/api/convert", `{"type":"gregToEt","date":"2023-02-30"}`, map[string]any{"error": `invalid date "2023-02-30": expected YYYY-MM-DD`}},
+		{"/api/format", `{"year":2016,"month":1,"day":1,"layout":""}`, map[string]any{"result": ""}},
+		{"/api/arithmetic", `{"year":2016,"month":1,"day":1,"operation":"days","value":10}`, map[string]any{"year": 2016.0, "month": 1.0, "day": 11.0}},
+		{"/api/leap", `{"year":2016}`, map[string]any{"isLeap": false}},
+		{"/api/leap", `{"year":2015,"month":13}`, map[string]any{"isLeap": true, "daysInMonth": 6.0}},
+		{"/api/convert", `{"type":"bogus"}`, map[string]any{"error": "Invalid conversion type"}},
+		{"/api/convert/timestamp", `{"timestamp":"2023-09-11T23:30:05-01:00"}`, map[string]any{
+			"year": 2015.0, "month": 13.0, "day": 6.0, "hour": 23.0, "minute": 30.0, "second": 5.0, "nanosecond": 0.0, "offset": "-01:00",
+		}},
+	}
+
+	for _, tt := range tests {
+		fields := postJSON(t, tt.path, tt.body)
+		if len(fields) != len(tt.want) {
+			t.Errorf("%s %s: got fields %v, want %v", tt.path, tt.body, fields, tt.want)
+			continue
+		}
+		for key, want := range tt.want {
+			if got, ok := fields[key]; !ok || got != want {
+				t.Errorf("%s %s: field %q = %v (present %v), want %v", tt.path, tt.body, key, got, ok, want)
+			}
+		}
+	}
+}
+
+func TestHealthEndpoints(t *testing.T) {
+	for _, path := range []string{"/healthz", "/readyz"} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		rec := httptest.NewRecorder()
+		newMux().ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Errorf("%s: expected status 200, got %d", path, rec.Code)
+		}
+		var resp HealthResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatal(err)
+		}
+		if resp.Status != "ok" {
+			t.Errorf("%s: expected status ok, got %q", path, resp.Status)
+		}
+	}
+}
+
+func TestUnknownAPIPath(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/api/convrt", nil)
+	rec := httptest.NewRecorder()
+	newMux().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("Expected status 404, got %d", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Expected JSON content type, got %q", ct)
+	}
+	var resp ErrorResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Error != "Not found" {
+		t.Errorf("Expected not found error, got %q", resp.Error)
+	}
+}
+
+func TestUnknownPagePath(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/nothing-here", nil)
+	rec := httptest.NewRecorder()
+	newMux().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", rec.Code)
+	}
+}
+
+func TestRootServesIndex(t *testing.T) {
+	// index.html is embedded, so no working directory is needed.
+	t.Chdir(t.TempDir())
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	newMux().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/html") {
+		t.Errorf("Expected HTML content type, got %q", ct)
+	}
+	if !strings.HasPrefix(rec.Body.String(), "<!doctype html>") {
+		t.Errorf("Expected the index page, got %.40q", rec.Body.String())
+	}
+}
+
+func TestLeapRange(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/leap/range?start=2010&end=2020", nil)
+	rec := httptest.NewRecorder()
+	newMux().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", rec.Code)
+	}
+	var years []LeapYear
+	if err := json.NewDecoder(rec.Body).Decode(&years); err != nil {
+		t.Fatal(err)
+	}
+	if len(years) != 11 {
+		t.Fatalf("Expected 11 years, got %d", len(years))
+	}
+	for _, y := range years {
+		want := y.Year == 2011 || y.Year == 2015 || y.Year == 2019
+		if y.IsLeap != want {
+			t.Errorf("Year %d: isLeap = %v, want %v", y.Year, y.IsLeap, want)
+		}
+	}
+}
+
+func TestLeapRangeBadInput(t *testing.T) {
+	for _, query := range []string{"start=2020&end=2010", "start=2010", "start=0&end=10", "start=1&end=5000"} {
+		req := httptest.NewRequest(http.MethodGet, "/api/leap/range?"+query, nil)
+		rec := httptest.NewRecorder()
+		newMux().ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status 400, got %d", query, rec.Code)
+		}
+	}
+}
+
+func TestLoadHolidaysFile(t *testing.T) {
+	// Load into a fresh default calendar so other tests see no extra holidays.
+	saved := ethiopiancalendar.DefaultCalendar
+	t.Cleanup(func() { ethiopiancalendar.DefaultCalendar = saved })
+	ethiopiancalendar.DefaultCalendar = ethiopiancalendar.NewCalendar(time.Sunday, ethiopiancalendar.LocaleEnglish)
+
+	path := filepath.Join(t.TempDir(), "holidays.json")
+	if err := os.WriteFile(path, []byte(`[{"month": 3, "day": 10, "name": "Regional Day"}]`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := loadHolidaysFile(path); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := (ethiopiancalendar.EtDate{Year: 2016, Month: 3, Day: 10}).IsHoliday(); err != nil || !got {
+		t.Errorf("IsHoliday after loading = %v, %v, want true", got, err)
+	}
+
+	if err := loadHolidaysFile(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("Expected error for a missing file")
+	}
+}
+
+func TestBothEndpoint(t *testing.T) {
+	tests := []struct {
+		body, lang string
+		want       BothResponse
+	}{
+		{`{"year":2016,"month":1,"day":1}`, "", BothResponse{
+			Ethiopian:   EthiopianDate{Year: 2016, Month: 1, Day: 1, MonthName: "Meskerem"},
+			Gregorian:   GregorianDate{Year: 2023, Month: 9, Day: 12},
+			Weekday:     2,
+			WeekdayName: "Tuesday",
+		}},
+		{`{"year":2015,"month":13,"day":6}`, "am", BothResponse{
+			Ethiopian:   EthiopianDate{Year: 2015, Month: 13, Day: 6, MonthName: "ጳጉሜን"},
+			Gregorian:   GregorianDate{Year: 2023, Month: 9, Day: 11},
+			Weekday:     1,
+			WeekdayName: "ሰኞ",
+		}},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/api/both", strings.NewReader(tt.body))
+		req.Header.Set("Accept-Language", tt.lang)
+		rec := httptest.NewRecorder()
+		newMux().ServeHTTP(rec, req)
+
+		var resp BothResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatal(err)
+		}
+		if resp != tt.want {
+			t.Errorf("%s: got %+v, want %+v", tt.body, resp, tt.want)
+		}
+
+		// The Gregorian half must convert back to the Ethiopian half.
+		g := resp.Gregorian
+		back := postJSON(t, "/api/convert", fmt.Sprintf(`{"type":"gregToEt","year":%d,"month":%d,"day":%d}`, g.Year, g.Month, g.Day))
+		if back["year"] != float64(resp.Ethiopian.Year) || back["month"] != float64(resp.Ethiopian.Month) || back["day"] != float64(resp.Ethiopian.Day) {
+			t.Errorf("%s: Gregorian %+v converts back to %v", tt.body, g, back)
+		}
+	}
+
+	fields := postJSON(t, "/api/both", `{"year":2016,"month":13,"day":6}`)
+	if fields["error"] != "day out of range for month" {
+		t.Errorf("Expected day error, got %v", fields)
+	}
+}
+
+func TestConvertStream(t *testing.T) {
+	body := strings.Join([]string{
+		`{"type":"etToGreg","year":2016,"month":1,"day":1}`,
+		`{"type":"gregToEt","date":"2023-09-11"}`,
+		`{"type":"etToGreg","year":2016,"month":13,"day":6}`,
+		`{"type":"etToGreg","year":"2016","month":1,"day":1}`,
+		`{"type":"gregToEt","year":2024,"month":1,"day":20}`,
+	}, "\n")
+	req := httptest.NewRequest(http.MethodPost, "/api/convert/stream", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	newMux().ServeHTTP(rec, req)
+
+	if ct := rec.Header().Get("Content-Type"); ct != "application/x-ndjson" {
+		t.Errorf("Content-Type = %q, want application/x-ndjson", ct)
+	}
+	want := []string{
+		`{"year":2023,"month":9,"day":12}`,
+		`{"year":2015,"month":13,"day":6,"monthName":"Pagume"}`,
+		`{"error":"day out of range for month"}`,
+		`{"error":"Invalid JSON"}`,
+		`{"year":2016,"month":5,"day":11,"monthName":"Tir"}`,
+	}
+	got := strings.Split(strings.TrimSuffix(rec.Body.String(), "\n"), "\n")
+	if !slices.Equal(got, want) {
+		t.Errorf("Got lines\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestConvertStreamSyntaxError(t *testing.T) {
+	body := `{"type":"etToGreg","year":2016,"month":1,"day":1}` + "\n{not json\n" + `{"type":"etToGreg","year":2016,"month":1,"day":2}`
+	req := httptest.NewRequest(http.MethodPost, "/api/convert/stream", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	newMux().ServeHTTP(rec, req)
+
+	// The bad line is reported inline and the stream carries on.
+	want := `{"year":2023,"month":9,"day":12}` + "\n" + `{"error":"Invalid JSON"}` + "\n" + `{"year":2023,"month":9,"day":13}` + "\n"
+	if rec.Body.String() != want {
+		t.Errorf("Got %q, want %q", rec.Body.String(), want)
+	}
+}
+
+func TestConvertStreamLongLine(t *testing.T) {
+	long := `{"type":"etToGreg","date":"` + strings.Repeat("x", maxStreamLine) + `"}`
+	body := long + "\n" + `{"type":"etToGreg","year":2016,"month":1,"day":1}` + "\n" + long
+	req := httptest.NewRequest(http.MethodPost, "/api/convert/stream", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	newMux().ServeHTTP(rec, req)
+
+	// Over-long lines are reported inline, even the last one, and the lines
+	// between them are still converted.
+	tooLong := fmt.Sprintf(`{"error":"Line too long: lines must be shorter than %d bytes"}`, maxStreamLine)
+	want := tooLong + "\n" + `{"year":2023,"month":9,"day":12}` + "\n" + tooLong + "\n"
+	if rec.Body.String() != want {
+		t.Errorf("Got %q, want %q", rec.Body.String(), want)
+	}
+}
+
+// brokenWriter is a ResponseWriter whose client has gone away.
+type brokenWriter struct {
+	header http.Header
+	writes int
+}
+
+func (w *brokenWriter) Header() http.Header { return w.header }
+func (w *brokenWriter) WriteHeader(int)     {}
+func (w *brokenWriter) Write([]byte) (int, error) {
+	w.writes++
+	return 0, errors.New("connection reset")
+}
+
+func TestConvertStreamClientGone(t *testing.T) {
+	body := strings.Repeat(`{"type":"etToGreg","year":2016,"month":1,"day":1}`+"\n", 3)
+	req := httptest.NewRequest(http.MethodPost, "/api/convert/stream", strings.NewReader(body))
+	w := &brokenWriter{header: http.Header{}}
+	newMux().ServeHTTP(w, req)
+
+	if w.writes != 1 {
+		t.Errorf("Got %d writes after the client went away, want 1", w.writes)
+	}
+}
+
+func TestTimestampLocalOffset(t *testing.T) {
+	addis, err := time.LoadLocation("Africa/Addis_Ababa")
+	if err != nil {
+		t.Skip("tz database unavailable:", err)
+	}
+	local := time.Local
+	time.Local = addis
+	t.Cleanup(func() { time.Local = local })
+
+	fields := postJSON(t, "/api/convert/timestamp", `{"timestamp":"2023-09-12T08:30:00+03:00"}`)
+	if got := fields["offset"]; got != "+03:00" {
+		t.Errorf("offset = %v, want +03:00", got)
+	}
+}
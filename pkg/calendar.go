@@ -0,0 +1,332 @@
+package ethiopiancalendar
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Epochs for Calendar.EpochJDN: the Julian Day Number of Meskerem 1 of
+// year 1 in each era.
+const (
+	// EpochAmeteMihret is the epoch of the Amete Mihret (Era of Mercy) count
+	// in everyday use, 27 August 8 CE in the proleptic Gregorian calendar.
+	EpochAmeteMihret = jdOffset
+	// EpochAmeteAlem is the epoch of the Amete Alem (Era of the World)
+	// count, 5500 years earlier, so 2016 Amete Mihret is 7516 Amete Alem.
+	EpochAmeteAlem = EpochAmeteMihret - 5500*365 - 5500/4
+)
+
+// Calendar holds configuration that would otherwise be package-level state:
+// the epoch, the registered holidays, the first day of the week and the
+// locale used for formatting. Separate Calendars are independent, so tests
+// and programs that need different settings can use several at once. A
+// Calendar is safe for concurrent use once its exported fields are set, and
+// must not be copied after first use.
+//
+// The package-level functions operate on DefaultCalendar: EtDate.ToJDN,
+// JDNToEt and the conversions built on them use its epoch, the week helpers
+// default to its WeekStart, Format uses its Locale, and RegisterHoliday and
+// HolidaysInYear use its holidays.
+type Calendar struct {
+	// EpochJDN is the Julian Day Number of Meskerem 1 of year 1. Zero means
+	// EpochAmeteMihret.
+	EpochJDN int
+	// WeekStart is the first day of the week used by WeeksInYear and the
+	// other week helpers.
+	WeekStart time.Weekday
+	// Locale selects the month and era names used by Format.
+	Locale string
+
+	mu       sync.RWMutex
+	holidays []fixedHoliday
+}
+
+// DefaultCalendar is the Calendar used by the package-level functions. It
+// counts years in Amete Mihret, its weeks start on Sunday (Ehud) and it
+// formats in English.
+var DefaultCalendar = NewCalendar(time.Sunday, LocaleEnglish)
+
+// NewCalendar returns a Calendar with the given week start and locale and no
+// registered holidays.
+func NewCalendar(weekStart time.Weekday, locale string) *Calendar {
+	return &Calendar{WeekStart: weekStart, Locale: locale}
+}
+
+// epoch returns the JDN of Meskerem 1 of year 1 in c.
+func (c *Calendar) epoch() int {
+	if c.EpochJDN == 0 {
+		return EpochAmeteMihret
+	}
+	return c.EpochJDN
+}
+
+// yearStartJDN returns the Julian Day Number of Meskerem 1 of the given year
+// in c without validating it.
+func (c *Calendar) yearStartJDN(year int) int {
+	return c.epoch() + 365*(year-1) + year/4
+}
+
+// ToJDN converts d to a Julian Day Number using c's epoch. See
+// EtDate.ToJDN.
+func (c *Calendar) ToJDN(d EtDate) (int, error) {
+	if err := d.Validate(); err != nil {
+		return 0, err
+	}
+	return c.yearStartJDN(d.Year) + 30*(d.Month-1) + d.Day - 1, nil
+}
+
+// JDNToEt converts a Julian Day Number to a date using c's epoch. JDNs
+// before the epoch return ErrBeforeEpoch. See the package-level JDNToEt.
+func (c *Calendar) JDNToEt(jdn int) (EtDate, error) {
+	if jdn < c.epoch() {
+		return EtDate{}, ErrBeforeEpoch
+	}
+	d := c.jdnToEt(jdn)
+	if err := d.Validate(); err != nil {
+		return EtDate{}, err
+	}
+	return d, nil
+}
+
+// jdnToEt converts a Julian Day Number on or after c's epoch to a date
+// without checking it.
+func (c *Calendar) jdnToEt(jdn int) EtDate {
+	// Every four-year cycle has 1461 days and ends with the leap year, so the
+	// year follows directly from the day count
+	year := (4*(jdn-c.epoch()) + 1463) / 1461
+
+	// Calculate month and day from the days since the start of the year
+	daysSinceYearStart := jdn - c.yearStartJDN(year)
+	month := daysSinceYearStart/30 + 1
+	day := daysSinceYearStart%30 + 1
+
+	return EtDate{Year: year, Month: month, Day: day}
+}
+
+// EpochDay returns the number of days from Meskerem 1 of year 1 in c to d.
+// See EtDate.EpochDay.
+func (c *Calendar) EpochDay(d EtDate) (int, error) {
+	jdn, err := c.ToJDN(d)
+	if err != nil {
+		return 0, err
+	}
+	return jdn - c.epoch(), nil
+}
+
+// FromEpochDay returns the date n days after Meskerem 1 of year 1 in c. See
+// the package-level FromEpochDay.
+func (c *Calendar) FromEpochDay(n int) (EtDate, error) {
+	if n < 0 {
+		return EtDate{}, ErrBeforeEpoch
+	}
+	return c.JDNToEt(n + c.epoch())
+}
+
+// AddDays adds days to d using c's epoch. See EtDate.AddDays.
+func (c *Calendar) AddDays(d EtDate, days int) (EtDate, error) {
+	jdn, err := c.ToJDN(d)
+	if err != nil {
+		return EtDate{}, err
+	}
+	if jdn+days < c.epoch() {
+		return EtDate{}, fmt.Errorf("adding %d days to %s would precede the calendar epoch, Meskerem 1 of year 1: %w", days, d.CSV(), ErrBeforeEpoch)
+	}
+	return c.JDNToEt(jdn + days)
+}
+
+// ToGregorian converts d to a Gregorian date using c's epoch.
+func (c *Calendar) ToGregorian(d EtDate) (int, int, int, error) {
+	jdn, err := c.ToJDN(d)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return JDNToGregorian(jdn)
+}
+
+// FromGregorian converts a Gregorian date to a date using c's epoch.
+func (c *Calendar) FromGregorian(year, month, day int) (EtDate, error) {
+	jdn, err := GregorianToJDN(year, month, day)
+	if err != nil {
+		return EtDate{}, fmt.Errorf("invalid Gregorian date: %w", err)
+	}
+	return c.JDNToEt(jdn)
+}
+
+// RegisterHoliday adds a holiday to c. See the package-level RegisterHoliday.
+func (c *Calendar) RegisterHoliday(month, day int, name string) error {
+	if err := validateHoliday(month, day, name); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.setHoliday(month, day, name)
+	return nil
+}
+
+// setHoliday adds or renames the holiday on month and day. The caller must
+// hold c.mu for writing.
+func (c *Calendar) setHoliday(month, day int, name string) {
+	for i, h := range c.holidays {
+		if h.month == month && h.day == day {
+			c.holidays[i].name = name
+			return
+		}
+	}
+	c.holidays = append(c.holidays, fixedHoliday{month, day, name})
+}
+
+// validateHoliday checks the arguments of RegisterHoliday.
+func validateHoliday(month, day int, name string) error {
+	if month < 1 || month > 13 {
+		return ErrInvalidMonth
+	}
+	// Year 3 is a leap year, so Pagume 6 is accepted.
+	if day < 1 || day > DaysInMonth(3, month) {
+		return ErrInvalidDay
+	}
+	if name == "" {
+		return errors.New("holiday name must not be empty")
+	}
+	return nil
+}
+
+// LoadHolidays registers with c the holidays read from r, a JSON array of
+// objects such as {"month": 3, "day": 10, "name": "Founders' Day"}. See
+// the package-level LoadHolidays.
+func (c *Calendar) LoadHolidays(r io.Reader) error {
+	var entries []struct {
+		Month int    `json:"month"`
+		Day   int    `json:"day"`
+		Name  string `json:"name"`
+		Type  string `json:"type"`
+	}
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return fmt.Errorf("invalid holiday JSON: %w", err)
+	}
+	// Check every entry first so a bad file registers nothing, then add them
+	// under one lock so readers see either none or all of them.
+	for i, e := range entries {
+		if e.Type != "" && e.Type != "fixed" {
+			return fmt.Errorf("holiday %d (%q): unsupported type %q", i, e.Name, e.Type)
+		}
+		if err := validateHoliday(e.Month, e.Day, e.Name); err != nil {
+			return fmt.Errorf("holiday %d (%q): %w", i, e.Name, err)
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, e := range entries {
+		c.setHoliday(e.Month, e.Day, e.Name)
+	}
+	return nil
+}
+
+// HolidaysInYear returns the fixed holidays and those registered with c for
+// the given year. See the package-level HolidaysInYear.
+func (c *Calendar) HolidaysInYear(year int) ([]Holiday, error) {
+	if year <= 0 {
+		return nil, ErrInvalidYear
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	holidays := make([]Holiday, 0, len(fixedHolidays)+len(c.holidays))
+	for _, h := range fixedHolidays {
+		d := EtDate{Year: year, Month: h.month, Day: h.day}
+		if h.name == "Genna" && IsLeap(year-1) {
+			d.Day--
+		}
+		holidays = append(holidays, Holiday{Date: d, Name: h.name})
+	}
+	for _, h := range c.holidays {
+		if h.day > DaysInMonth(year, h.month) {
+			continue
+		}
+		holidays = append(holidays, Holiday{Date: EtDate{Year: year, Month: h.month, Day: h.day}, Name: h.name})
+	}
+	slices.SortStableFunc(holidays, func(a, b Holiday) int {
+		return cmp.Or(cmp.Compare(a.Date.Month, b.Date.Month), cmp.Compare(a.Date.Day, b.Date.Day))
+	})
+	return holidays, nil
+}
+
+// IsHoliday reports whether d is a fixed holiday or one registered with c.
+func (c *Calendar) IsHoliday(d EtDate) (bool, error) {
+	if err := d.Validate(); err != nil {
+		return false, err
+	}
+	holidays, err := c.HolidaysInYear(d.Year)
+	if err != nil {
+		return false, err
+	}
+	for _, h := range holidays {
+		if h.Date == d {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// HolidaysBetween returns the holidays of c from start to end inclusive. See
+// the package-level HolidaysBetween.
+func (c *Calendar) HolidaysBetween(start, end EtDate) ([]Holiday, error) {
+	startJDN, err := c.ToJDN(start)
+	if err != nil {
+		return nil, err
+	}
+	endJDN, err := c.ToJDN(end)
+	if err != nil {
+		return nil, err
+	}
+	if endJDN < startJDN {
+		return nil, ErrInvalidRange
+	}
+
+	var holidays []Holiday
+	for year := start.Year; year <= end.Year; year++ {
+		inYear, err := c.HolidaysInYear(year)
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range inYear {
+			jdn, err := c.ToJDN(h.Date)
+			if err != nil {
+				return nil, err
+			}
+			if jdn >= startJDN && jdn <= endJDN {
+				holidays = append(holidays, h)
+			}
+		}
+	}
+	return holidays, nil
+}
+
+// WeeksInYear returns the number of weeks the year spans when weeks begin on
+// c.WeekStart. See the package-level WeeksInYear.
+func (c *Calendar) WeeksInYear(year int) int {
+	return WeeksInYear(year, c.WeekStart)
+}
+
+// WeekOfYear returns the week of the year containing d when weeks begin on
+// c.WeekStart. See EtDate.WeekOfYear.
+func (c *Calendar) WeekOfYear(d EtDate) (int, error) {
+	return d.WeekOfYear(c.WeekStart)
+}
+
+// MonthGrid lays out the month with weeks beginning on c.WeekStart. See the
+// package-level MonthGrid.
+func (c *Calendar) MonthGrid(year, month int) ([][7]int, error) {
+	return MonthGrid(year, month, c.WeekStart)
+}
+
+// Format formats d like EtDate.FormatLocale using c.Locale.
+func (c *Calendar) Format(d EtDate, layout string) string {
+	return d.FormatLocale(layout, c.Locale)
+}
@@ -0,0 +1,189 @@
+package ethiopiancalendar
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCalendarsAreIndependent(t *testing.T) {
+	en := NewCalendar(time.Sunday, LocaleEnglish)
+	am := NewCalendar(time.Monday, LocaleAmharic)
+
+	if err := en.RegisterHoliday(3, 10, "Company Day"); err != nil {
+		t.Fatal(err)
+	}
+	if err := am.RegisterHoliday(7, 5, "Founders' Day"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		cal  *Calendar
+		date EtDate
+		want bool
+	}{
+		{en, EtDate{Year: 2016, Month: 3, Day: 10}, true},
+		{en, EtDate{Year: 2016, Month: 7, Day: 5}, false},
+		{am, EtDate{Year: 2016, Month: 3, Day: 10}, false},
+		{am, EtDate{Year: 2016, Month: 7, Day: 5}, true},
+		{am, EtDate{Year: 2016, Month: 1, Day: 17}, true},
+	}
+	for _, tt := range tests {
+		got, err := tt.cal.IsHoliday(tt.date)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("IsHoliday(%v) on %s calendar = %v, want %v", tt.date, tt.cal.Locale, got, tt.want)
+		}
+	}
+
+	// The default calendar sees neither registration.
+	for _, d := range []EtDate{{Year: 2016, Month: 3, Day: 10}, {Year: 2016, Month: 7, Day: 5}} {
+		if got, _ := d.IsHoliday(); got {
+			t.Errorf("%v.IsHoliday() = true on DefaultCalendar, want false", d)
+		}
+	}
+
+	d := EtDate{Year: 2016, Month: 1, Day: 1}
+	if got, want := en.Format(d, "Month DD"), "Meskerem 01"; got != want {
+		t.Errorf("en.Format = %q, want %q", got, want)
+	}
+	if got, want := am.Format(d, "Month DD"), "መስከረም 01"; got != want {
+		t.Errorf("am.Format = %q, want %q", got, want)
+	}
+
+	if got := en.WeeksInYear(2016); got != WeeksInYear(2016, time.Sunday) {
+		t.Errorf("en.WeeksInYear(2016) = %d, want %d", got, WeeksInYear(2016, time.Sunday))
+	}
+	if got := am.WeeksInYear(2016); got != WeeksInYear(2016, time.Monday) {
+		t.Errorf("am.WeeksInYear(2016) = %d, want %d", got, WeeksInYear(2016, time.Monday))
+	}
+}
+
+func TestCalendarsInParallel(t *testing.T) {
+	for i, name := range []string{"First", "Second"} {
+		cal := NewCalendar(time.Sunday, LocaleEnglish)
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			for day := 1; day <= 30; day++ {
+				if err := cal.RegisterHoliday(i+2, day, name); err != nil {
+					t.Fatal(err)
+				}
+			}
+			holidays, err := cal.HolidaysBetween(EtDate{Year: 2016, Month: i + 2, Day: 1}, EtDate{Year: 2016, Month: i + 2, Day: 30})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(holidays) != 30 {
+				t.Fatalf("HolidaysBetween returned %d holidays, want 30", len(holidays))
+			}
+			for _, h := range holidays {
+				if h.Name != name {
+					t.Errorf("Holiday on %v = %q, want %q", h.Date, h.Name, name)
+				}
+			}
+		})
+	}
+}
+
+func TestCalendarEpochs(t *testing.T) {
+	alem := NewCalendar(time.Sunday, LocaleEnglish)
+	alem.EpochJDN = EpochAmeteAlem
+
+	// 12 September 2023 is Meskerem 1 in both eras, 5500 years apart.
+	mihret, err := DefaultCalendar.FromGregorian(2023, 9, 12)
+	if err != nil || mihret != (EtDate{Year: 2016, Month: 1, Day: 1}) {
+		t.Fatalf("DefaultCalendar.FromGregorian(2023-09-12) = %v, %v", mihret, err)
+	}
+	got, err := alem.FromGregorian(2023, 9, 12)
+	if err != nil || got != (EtDate{Year: 7516, Month: 1, Day: 1}) {
+		t.Fatalf("Amete Alem FromGregorian(2023-09-12) = %v, %v, want 7516-01-01", got, err)
+	}
+	for _, d := range []EtDate{{Year: 2015, Month: 13, Day: 6}, {Year: 2016, Month: 5, Day: 11}, {Year: 1, Month: 1, Day: 1}} {
+		want, err := d.ToJDN()
+		if err != nil {
+			t.Fatal(err)
+		}
+		shifted := EtDate{Year: d.Year + 5500, Month: d.Month, Day: d.Day}
+		if jdn, err := alem.ToJDN(shifted); err != nil || jdn != want {
+			t.Errorf("Amete Alem ToJDN(%v) = %d, %v, want %d", shifted, jdn, err, want)
+		}
+		if back, err := alem.JDNToEt(want); err != nil || back != shifted {
+			t.Errorf("Amete Alem JDNToEt(%d) = %v, %v, want %v", want, back, err, shifted)
+		}
+	}
+	gy, gm, gd, err := alem.ToGregorian(EtDate{Year: 7516, Month: 1, Day: 1})
+	if err != nil || gy != 2023 || gm != 9 || gd != 12 {
+		t.Errorf("Amete Alem ToGregorian(7516-01-01) = %d-%d-%d, %v", gy, gm, gd, err)
+	}
+
+	// Day arithmetic counts from the calendar's own epoch.
+	newYear := EtDate{Year: 7516, Month: 1, Day: 1}
+	if n, err := alem.EpochDay(newYear); err != nil || n != 2460200-EpochAmeteAlem {
+		t.Errorf("Amete Alem EpochDay(%v) = %d, %v, want %d", newYear, n, err, 2460200-EpochAmeteAlem)
+	}
+	if got, err := alem.FromEpochDay(2460200 - EpochAmeteAlem); err != nil || got != newYear {
+		t.Errorf("Amete Alem FromEpochDay = %v, %v, want %v", got, err, newYear)
+	}
+	if got, err := alem.AddDays(newYear, -1); err != nil || got != (EtDate{Year: 7515, Month: 13, Day: 6}) {
+		t.Errorf("Amete Alem AddDays(%v, -1) = %v, %v, want 7515-13-06", newYear, got, err)
+	}
+	if _, err := alem.AddDays(EtDate{Year: 1, Month: 1, Day: 1}, -1); !errors.Is(err, ErrBeforeEpoch) {
+		t.Errorf("Amete Alem AddDays before the epoch error = %v, want ErrBeforeEpoch", err)
+	}
+	holidays, err := alem.HolidaysBetween(EtDate{Year: 7515, Month: 13, Day: 1}, newYear)
+	if err != nil || len(holidays) != 1 || holidays[0].Date != newYear {
+		t.Errorf("Amete Alem HolidaysBetween = %v, %v, want Enkutatash on %v", holidays, err, newYear)
+	}
+
+	// A zero EpochJDN means Amete Mihret.
+	var zero Calendar
+	if jdn, _ := zero.ToJDN(EtDate{Year: 2016, Month: 1, Day: 1}); jdn != 2460200 {
+		t.Errorf("zero Calendar ToJDN(2016-01-01) = %d, want 2460200", jdn)
+	}
+	if _, err := DefaultCalendar.JDNToEt(EpochAmeteMihret - 1); !errors.Is(err, ErrBeforeEpoch) {
+		t.Errorf("JDNToEt before the epoch error = %v, want ErrBeforeEpoch", err)
+	}
+}
+
+func TestCalendarLoadHolidaysAtomic(t *testing.T) {
+	cal := NewCalendar(time.Sunday, LocaleEnglish)
+	base, err := cal.HolidaysInYear(2016)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var entries []string
+	for day := 1; day <= 30; day++ {
+		entries = append(entries, fmt.Sprintf(`{"month": 2, "day": %d, "name": "Day %d"}`, day, day))
+	}
+	done := make(chan error)
+	go func() {
+		done <- cal.LoadHolidays(strings.NewReader("[" + strings.Join(entries, ",") + "]"))
+	}()
+
+	// Readers racing the load see either none of its holidays or all of them.
+	for {
+		holidays, err := cal.HolidaysInYear(2016)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n := len(holidays); n != len(base) && n != len(base)+30 {
+			t.Fatalf("HolidaysInYear during LoadHolidays returned %d holidays, want %d or %d", n, len(base), len(base)+30)
+		}
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatal(err)
+			}
+			if holidays, _ := cal.HolidaysInYear(2016); len(holidays) != len(base)+30 {
+				t.Errorf("HolidaysInYear after LoadHolidays returned %d holidays, want %d", len(holidays), len(base)+30)
+			}
+			return
+		default:
+		}
+	}
+}
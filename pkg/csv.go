@@ -0,0 +1,51 @@
+package ethiopiancalendar
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CSV returns the date in the canonical YYYY-MM-DD form used for CSV export.
+// ParseCSVDate reads it back. With encoding/csv, write dates as ordinary
+// fields and parse them on the way in:
+//
+//	w := csv.NewWriter(out)
+//	w.Write([]string{name, date.CSV()})
+//	...
+//	record, err := r.Read()
+//	date, err := ethiopiancalendar.ParseCSVDate(record[1])
+func (d EtDate) CSV() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// ParseCSVDate parses a date in the YYYY-MM-DD form produced by CSV and
+// validates it.
+func ParseCSVDate(s string) (EtDate, error) {
+	parts := strings.Split(s, "-")
+	if len(parts) != 3 {
+		return EtDate{}, fmt.Errorf("invalid CSV date %q: expected YYYY-MM-DD", s)
+	}
+	var fields [3]int
+	for i, p := range parts {
+		n, err := parseDigits(p)
+		if err != nil {
+			return EtDate{}, fmt.Errorf("invalid CSV date %q: %w", s, err)
+		}
+		fields[i] = n
+	}
+	d := EtDate{Year: fields[0], Month: fields[1], Day: fields[2]}
+	if err := d.Validate(); err != nil {
+		return EtDate{}, err
+	}
+	return d, nil
+}
+
+// parseDigits parses a non-empty string of ASCII digits.
+func parseDigits(s string) (int, error) {
+	if s == "" || strings.TrimLeft(s, "0123456789") != "" {
+		return 0, errors.New("expected digits, got " + strconv.Quote(s))
+	}
+	return strconv.Atoi(s)
+}
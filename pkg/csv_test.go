@@ -0,0 +1,53 @@
+package ethiopiancalendar
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+)
+
+func TestCSVRoundTrip(t *testing.T) {
+	dates := []EtDate{
+		{Year: 2016, Month: 1, Day: 1},
+		{Year: 2015, Month: 13, Day: 6},
+		{Year: 7, Month: 12, Day: 30},
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	for i, d := range dates {
+		if err := w.Write([]string{string(rune('a' + i)), d.CSV()}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != "a,2016-01-01\nb,2015-13-06\nc,0007-12-30\n" {
+		t.Errorf("Unexpected CSV output %q", got)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, record := range records {
+		d, err := ParseCSVDate(record[1])
+		if err != nil {
+			t.Errorf("ParseCSVDate(%q) returned error: %v", record[1], err)
+			continue
+		}
+		if d != dates[i] {
+			t.Errorf("ParseCSVDate(%q) = %+v, want %+v", record[1], d, dates[i])
+		}
+	}
+}
+
+func TestParseCSVDateInvalid(t *testing.T) {
+	for _, s := range []string{"", "2016-01", "2016/01/01", "2016-+1-01", "2016-01-01-01", "2016-13-06", "0000-01-01"} {
+		if d, err := ParseCSVDate(s); err == nil {
+			t.Errorf("ParseCSVDate(%q) = %+v, expected error", s, d)
+		}
+	}
+}
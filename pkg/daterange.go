@@ -0,0 +1,83 @@
+package ethiopiancalendar
+
+// DateRange is the span of days from Start to End, both inclusive.
+type DateRange struct {
+	Start, End EtDate
+}
+
+// EthiopianRangeFromGregorian converts both ends of the inclusive Gregorian
+// span from startG to endG to Ethiopian dates. It returns ErrInvalidRange if
+// endG is before startG.
+func EthiopianRangeFromGregorian(startG, endG GregorianDate) (start, end EtDate, err error) {
+	start, err = FromGregorian(startG.Year, startG.Month, startG.Day)
+	if err != nil {
+		return EtDate{}, EtDate{}, err
+	}
+	end, err = FromGregorian(endG.Year, endG.Month, endG.Day)
+	if err != nil {
+		return EtDate{}, EtDate{}, err
+	}
+	if days, _ := Between(start, end); days < 0 {
+		return EtDate{}, EtDate{}, ErrInvalidRange
+	}
+	return start, end, nil
+}
+
+// String returns the range as "2016-01-01 – 2016-13-05".
+func (r DateRange) String() string {
+	return r.Start.CSV() + " – " + r.End.CSV()
+}
+
+// Days returns the number of days in the range, counting both ends, so a
+// range that starts and ends on the same day has 1 day. It returns
+// ErrInvalidRange if End is before Start.
+func (r DateRange) Days() (int, error) {
+	start, end, err := r.jdns()
+	if err != nil {
+		return 0, err
+	}
+	if end < start {
+		return 0, ErrInvalidRange
+	}
+	return end - start + 1, nil
+}
+
+// Contains reports whether d falls within the range, including its first
+// and last days. It is false if d or either end of the range is invalid.
+func (r DateRange) Contains(d EtDate) bool {
+	start, end, err := r.jdns()
+	if err != nil {
+		return false
+	}
+	jdn, err := d.ToJDN()
+	if err != nil {
+		return false
+	}
+	return start <= jdn && jdn <= end
+}
+
+// Overlaps reports whether r and other share at least one day. Ranges that
+// touch, with one ending on the day the other starts, overlap; ranges that
+// are merely adjacent, with one ending the day before the other starts, do
+// not. It is false if either range has an invalid end.
+func (r DateRange) Overlaps(other DateRange) bool {
+	start, end, err := r.jdns()
+	if err != nil {
+		return false
+	}
+	otherStart, otherEnd, err := other.jdns()
+	if err != nil {
+		return false
+	}
+	return start <= otherEnd && otherStart <= end && start <= end && otherStart <= otherEnd
+}
+
+func (r DateRange) jdns() (start, end int, err error) {
+	if start, err = r.Start.ToJDN(); err != nil {
+		return 0, 0, err
+	}
+	if end, err = r.End.ToJDN(); err != nil {
+		return 0, 0, err
+	}
+	return start, end, nil
+}
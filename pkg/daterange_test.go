@@ -0,0 +1,114 @@
+package ethiopiancalendar
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDateRangeStringAndDays(t *testing.T) {
+	r := DateRange{Start: EtDate{Year: 2016, Month: 1, Day: 1}, End: EtDate{Year: 2016, Month: 13, Day: 5}}
+	if got, want := r.String(), "2016-01-01 – 2016-13-05"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	tests := []struct {
+		r    DateRange
+		want int
+	}{
+		{r, 365},
+		{DateRange{EtDate{Year: 2015, Month: 1, Day: 1}, EtDate{Year: 2015, Month: 13, Day: 6}}, 366},
+		{DateRange{EtDate{Year: 2016, Month: 1, Day: 1}, EtDate{Year: 2016, Month: 1, Day: 1}}, 1},
+		{DateRange{EtDate{Year: 2015, Month: 13, Day: 6}, EtDate{Year: 2016, Month: 1, Day: 1}}, 2},
+	}
+	for _, tt := range tests {
+		got, err := tt.r.Days()
+		if err != nil {
+			t.Errorf("%v.Days() returned error: %v", tt.r, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%v.Days() = %d, want %d", tt.r, got, tt.want)
+		}
+	}
+
+	if _, err := (DateRange{EtDate{Year: 2016, Month: 1, Day: 2}, EtDate{Year: 2016, Month: 1, Day: 1}}).Days(); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("Days() of reversed range error = %v, want ErrInvalidRange", err)
+	}
+	if _, err := (DateRange{EtDate{Year: 2016, Month: 1, Day: 1}, EtDate{Year: 2016, Month: 13, Day: 6}}).Days(); !errors.Is(err, ErrInvalidDay) {
+		t.Errorf("Days() with invalid end error = %v, want ErrInvalidDay", err)
+	}
+}
+
+func TestDateRangeContains(t *testing.T) {
+	r := DateRange{Start: EtDate{Year: 2016, Month: 3, Day: 10}, End: EtDate{Year: 2016, Month: 4, Day: 5}}
+	tests := []struct {
+		d    EtDate
+		want bool
+	}{
+		{EtDate{Year: 2016, Month: 3, Day: 9}, false},
+		{EtDate{Year: 2016, Month: 3, Day: 10}, true},
+		{EtDate{Year: 2016, Month: 3, Day: 30}, true},
+		{EtDate{Year: 2016, Month: 4, Day: 5}, true},
+		{EtDate{Year: 2016, Month: 4, Day: 6}, false},
+		{EtDate{Year: 2015, Month: 3, Day: 20}, false},
+		{EtDate{Year: 2016, Month: 3, Day: 31}, false},
+	}
+	for _, tt := range tests {
+		if got := r.Contains(tt.d); got != tt.want {
+			t.Errorf("%v.Contains(%v) = %v, want %v", r, tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestDateRangeOverlaps(t *testing.T) {
+	r := DateRange{Start: EtDate{Year: 2016, Month: 3, Day: 10}, End: EtDate{Year: 2016, Month: 3, Day: 20}}
+	tests := []struct {
+		name  string
+		other DateRange
+		want  bool
+	}{
+		{"same", r, true},
+		{"inside", DateRange{EtDate{Year: 2016, Month: 3, Day: 12}, EtDate{Year: 2016, Month: 3, Day: 14}}, true},
+		{"surrounding", DateRange{EtDate{Year: 2016, Month: 1, Day: 1}, EtDate{Year: 2016, Month: 13, Day: 5}}, true},
+		{"partial", DateRange{EtDate{Year: 2016, Month: 3, Day: 15}, EtDate{Year: 2016, Month: 4, Day: 1}}, true},
+		{"touching end", DateRange{EtDate{Year: 2016, Month: 3, Day: 20}, EtDate{Year: 2016, Month: 3, Day: 25}}, true},
+		{"touching start", DateRange{EtDate{Year: 2016, Month: 3, Day: 1}, EtDate{Year: 2016, Month: 3, Day: 10}}, true},
+		{"adjacent after", DateRange{EtDate{Year: 2016, Month: 3, Day: 21}, EtDate{Year: 2016, Month: 3, Day: 25}}, false},
+		{"adjacent before", DateRange{EtDate{Year: 2016, Month: 3, Day: 1}, EtDate{Year: 2016, Month: 3, Day: 9}}, false},
+		{"disjoint", DateRange{EtDate{Year: 2017, Month: 3, Day: 10}, EtDate{Year: 2017, Month: 3, Day: 20}}, false},
+		{"reversed", DateRange{EtDate{Year: 2016, Month: 3, Day: 20}, EtDate{Year: 2016, Month: 3, Day: 10}}, false},
+		{"invalid", DateRange{EtDate{Year: 2016, Month: 3, Day: 10}, EtDate{Year: 2016, Month: 3, Day: 31}}, false},
+	}
+	for _, tt := range tests {
+		if got := r.Overlaps(tt.other); got != tt.want {
+			t.Errorf("%s: %v.Overlaps(%v) = %v, want %v", tt.name, r, tt.other, got, tt.want)
+		}
+		if got := tt.other.Overlaps(r); got != tt.want {
+			t.Errorf("%s: %v.Overlaps(%v) = %v, want %v", tt.name, tt.other, r, got, tt.want)
+		}
+	}
+}
+
+func TestEthiopianRangeFromGregorian(t *testing.T) {
+	start, end, err := EthiopianRangeFromGregorian(GregorianDate{Year: 2023, Month: 9, Day: 12}, GregorianDate{Year: 2024, Month: 1, Day: 20})
+	if err != nil {
+		t.Fatalf("EthiopianRangeFromGregorian error: %v", err)
+	}
+	if want := (EtDate{Year: 2016, Month: 1, Day: 1}); start != want {
+		t.Errorf("start = %v, want %v", start, want)
+	}
+	if want := (EtDate{Year: 2016, Month: 5, Day: 11}); end != want {
+		t.Errorf("end = %v, want %v", end, want)
+	}
+
+	// A one-day span is allowed.
+	if start, end, err := EthiopianRangeFromGregorian(GregorianDate{Year: 2023, Month: 9, Day: 11}, GregorianDate{Year: 2023, Month: 9, Day: 11}); err != nil || start != end {
+		t.Errorf("one-day span = %v, %v, %v", start, end, err)
+	}
+	if _, _, err := EthiopianRangeFromGregorian(GregorianDate{Year: 2024, Month: 1, Day: 20}, GregorianDate{Year: 2023, Month: 9, Day: 12}); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("reversed span error = %v, want ErrInvalidRange", err)
+	}
+	if _, _, err := EthiopianRangeFromGregorian(GregorianDate{Year: 2023, Month: 2, Day: 30}, GregorianDate{Year: 2023, Month: 9, Day: 12}); !errors.Is(err, ErrInvalidGregorianDay) {
+		t.Errorf("invalid start error = %v, want ErrInvalidGregorianDay", err)
+	}
+}
@@ -0,0 +1,59 @@
+package ethiopiancalendar
+
+import (
+	"fmt"
+	"time"
+)
+
+// EtDateTime is an Ethiopian date with a time of day. The time of day uses
+// the civil 24-hour clock starting at midnight, not the traditional
+// Ethiopian clock that counts hours from 6:00.
+type EtDateTime struct {
+	Date                             EtDate
+	Hour, Minute, Second, Nanosecond int
+	// Location is the time zone the date and time of day are expressed in.
+	Location *time.Location
+}
+
+// FromRFC3339 parses an RFC 3339 Gregorian timestamp such as
+// "2023-09-12T08:30:00+03:00" and returns the Ethiopian date and time.
+//
+// The timestamp is not converted to UTC or to local time: the date and time
+// of day are those written in the timestamp, in its own offset, and Location
+// is a fixed zone with that offset. So "2023-09-11T23:30:00-01:00" is Pagume
+// 6, 2015 at 23:30 even though it is already Meskerem 1 in UTC.
+func FromRFC3339(s string) (EtDateTime, error) {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return EtDateTime{}, fmt.Errorf("invalid RFC 3339 timestamp: %w", err)
+	}
+	d, err := FromGregorian(t.Year(), int(t.Month()), t.Day())
+	if err != nil {
+		return EtDateTime{}, err
+	}
+	_, offset := t.Zone()
+	return EtDateTime{
+		Date:       d,
+		Hour:       t.Hour(),
+		Minute:     t.Minute(),
+		Second:     t.Second(),
+		Nanosecond: t.Nanosecond(),
+		// time.Parse returns time.Local when the offset matches the local
+		// zone, which has other offsets at other dates, so pin the offset.
+		Location: time.FixedZone("", offset),
+	}, nil
+}
+
+// Time returns the instant dt describes, in dt.Location, or in UTC if
+// Location is nil.
+func (dt EtDateTime) Time() (time.Time, error) {
+	gy, gm, gd, err := dt.Date.ToGregorian()
+	if err != nil {
+		return time.Time{}, err
+	}
+	loc := dt.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	return time.Date(gy, time.Month(gm), gd, dt.Hour, dt.Minute, dt.Second, dt.Nanosecond, loc), nil
+}
@@ -0,0 +1,68 @@
+package ethiopiancalendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFromRFC3339(t *testing.T) {
+	tests := []struct {
+		in                                  string
+		date                                EtDate
+		hour, minute, second, nanos, offset int
+	}{
+		{"2023-09-12T08:30:15+03:00", EtDate{Year: 2016, Month: 1, Day: 1}, 8, 30, 15, 0, 3 * 3600},
+		// Already Meskerem 1 in UTC, but the timestamp's own day is kept.
+		{"2023-09-11T23:30:00-01:00", EtDate{Year: 2015, Month: 13, Day: 6}, 23, 30, 0, 0, -3600},
+		{"2023-09-12T00:00:00.25Z", EtDate{Year: 2016, Month: 1, Day: 1}, 0, 0, 0, 250000000, 0},
+	}
+	for _, tt := range tests {
+		got, err := FromRFC3339(tt.in)
+		if err != nil {
+			t.Errorf("FromRFC3339(%q) returned error: %v", tt.in, err)
+			continue
+		}
+		if got.Date != tt.date || got.Hour != tt.hour || got.Minute != tt.minute || got.Second != tt.second || got.Nanosecond != tt.nanos {
+			t.Errorf("FromRFC3339(%q) = %+v, want %v %02d:%02d:%02d.%09d", tt.in, got, tt.date, tt.hour, tt.minute, tt.second, tt.nanos)
+		}
+		if _, offset := time.Date(2023, 9, 12, 0, 0, 0, 0, got.Location).Zone(); offset != tt.offset {
+			t.Errorf("FromRFC3339(%q) offset = %d, want %d", tt.in, offset, tt.offset)
+		}
+	}
+
+	for _, s := range []string{"", "2023-09-12", "2023-09-12 08:30:00+03:00", "0001-01-01T00:00:00Z"} {
+		if got, err := FromRFC3339(s); err == nil {
+			t.Errorf("FromRFC3339(%q) = %+v, expected error", s, got)
+		}
+	}
+}
+
+func TestFromRFC3339LocalOffset(t *testing.T) {
+	addis, err := time.LoadLocation("Africa/Addis_Ababa")
+	if err != nil {
+		t.Skip("tz database unavailable:", err)
+	}
+	// With the offset equal to the local zone's, time.Parse returns
+	// time.Local, whose offset in year 0 is local mean time (+02:34).
+	local := time.Local
+	time.Local = addis
+	t.Cleanup(func() { time.Local = local })
+
+	dt, err := FromRFC3339("2023-09-12T08:30:00+03:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dt.Location == time.Local {
+		t.Error("Location is time.Local, want a fixed zone")
+	}
+	if _, offset := time.Date(1, 1, 1, 0, 0, 0, 0, dt.Location).Zone(); offset != 3*3600 {
+		t.Errorf("offset in year 1 = %d, want %d", offset, 3*3600)
+	}
+	tm, err := dt.Time()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2023, 9, 12, 5, 30, 0, 0, time.UTC); !tm.Equal(want) || tm.Format("-07:00") != "+03:00" {
+		t.Errorf("Time() = %v, want %v at +03:00", tm, want)
+	}
+}
@@ -3,8 +3,8 @@ package ethiopiancalendar
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
-	"time"
 )
 
 // EtDate represents a date in the Ethiopian Calendar.
@@ -14,8 +14,69 @@ type EtDate struct {
 	Day   int
 }
 
+// GregorianDate represents a date in the proleptic Gregorian Calendar.
+type GregorianDate struct {
+	Year  int
+	Month int
+	Day   int
+}
+
 var monthNames = []string{"", "Meskerem", "Tikimt", "Hidar", "Tahsas", "Tir", "Yekatit", "Megabit", "Miazia", "Genbot", "Sene", "Hamle", "Nehase", "Pagume"}
 
+// shortMonthNames holds the three-letter English abbreviations used by the
+// Mon layout token. Amharic month names are not abbreviated.
+var shortMonthNames = []string{"", "Mes", "Tik", "Hid", "Tah", "Tir", "Yek", "Meg", "Mia", "Gen", "Sen", "Ham", "Neh", "Pag"}
+
+var amharicMonthNames = []string{"", "መስከረም", "ጥቅምት", "ኅዳር", "ታኅሣሥ", "ጥር", "የካቲት", "መጋቢት", "ሚያዝያ", "ግንቦት", "ሰኔ", "ሐምሌ", "ነሐሴ", "ጳጉሜን"}
+
+// Locales supported by FormatLocale.
+const (
+	LocaleEnglish = "en"
+	LocaleAmharic = "am"
+)
+
+// Era labels for the Amete Mihret (Year of Mercy) era used by EtDate.
+const (
+	eraEnglish = "EC"
+	eraAmharic = "ዓ.ም."
+)
+
+// Errors returned when a date or date component is out of range.
+var (
+	ErrInvalidYear           = errors.New("year must be positive")
+	ErrInvalidMonth          = errors.New("month must be between 1 and 13")
+	ErrInvalidDay            = errors.New("day out of range for month")
+	ErrInvalidOrdinal        = errors.New("ordinal out of range for year")
+	ErrBeforeEpoch           = errors.New("jdn before Ethiopian epoch")
+	ErrInvalidGregorianYear  = errors.New("invalid Gregorian year")
+	ErrInvalidGregorianMonth = errors.New("month must be between 1 and 12")
+	ErrInvalidGregorianDay   = errors.New("day is out of range for the given month")
+	ErrInvalidRange          = errors.New("end date is before start date")
+)
+
+// ValidationError reports which component of an EtDate failed validation.
+// It is returned by Validate and ValidateWith and wraps one of
+// ErrInvalidYear, ErrInvalidMonth or ErrInvalidDay, so errors.Is still
+// matches the sentinel. Its message is the sentinel's.
+type ValidationError struct {
+	Field string // "year", "month" or "day"
+	Value int    // the rejected value
+	Msg   string
+	Err   error
+}
+
+func (e *ValidationError) Error() string { return e.Msg }
+
+func (e *ValidationError) Unwrap() error { return e.Err }
+
+func newValidationError(field string, value int, err error) *ValidationError {
+	return &ValidationError{Field: field, Value: value, Msg: err.Error(), Err: err}
+}
+
+// gregorianMonthDays holds the day counts of the Gregorian months in a
+// common year.
+var gregorianMonthDays = [12]int{31, 28, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+
 const jdOffset = 1724221 // JDN for 1/1/1 EC (1 Mäskäräm 1), approximately 8/27/8 CE
 
 // IsLeap checks if the given Ethiopian year is a leap year.
@@ -26,6 +87,58 @@ func IsLeap(year int) bool {
 	return (year % 4) == 3
 }
 
+// LeapYear pairs an Ethiopian year with whether it is a leap year.
+type LeapYear struct {
+	Year   int
+	IsLeap bool
+}
+
+// EthiopianLeapYearsInGregorian returns the Ethiopian years that overlap the
+// Gregorian year gregYear, in order, with their leap flags. There are always
+// two: the year that ends in September and the one that starts then.
+func EthiopianLeapYearsInGregorian(gregYear int) ([]LeapYear, error) {
+	first, err := FromGregorian(gregYear, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	last, err := FromGregorian(gregYear, 12, 31)
+	if err != nil {
+		return nil, err
+	}
+	var years []LeapYear
+	for y := first.Year; y <= last.Year; y++ {
+		years = append(years, LeapYear{Year: y, IsLeap: IsLeap(y)})
+	}
+	return years, nil
+}
+
+// LeapDaysBetween returns the number of leap years in [startYear, endYear),
+// which is also the number of Pagume 6 days in that span. It returns 0 when
+// endYear is not after startYear.
+func LeapDaysBetween(startYear, endYear int) int {
+	if endYear <= startYear {
+		return 0
+	}
+	return leapYearsBefore(endYear) - leapYearsBefore(startYear)
+}
+
+// leapYearsBefore returns the number of leap years from year 1 up to, but not
+// including, year.
+func leapYearsBefore(year int) int {
+	if year <= 0 {
+		return 0
+	}
+	return year / 4
+}
+
+// daysInYear returns the number of days in the given Ethiopian year.
+func daysInYear(year int) int {
+	if IsLeap(year) {
+		return 366
+	}
+	return 365
+}
+
 // DaysInMonth returns the number of days in the specified Ethiopian month and year.
 func DaysInMonth(year, month int) int {
 	if month < 1 || month > 13 {
@@ -40,95 +153,349 @@ func DaysInMonth(year, month int) int {
 	return 30
 }
 
-// Validate checks if the EtDate is valid.
+// commonMonthLengths holds the month lengths of a common year, indexed by
+// month with index 0 unused.
+var commonMonthLengths = [14]int{0, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 5}
+
+// MonthLengths returns the length of every month of the year, indexed by
+// month (index 0 is unused and 0), for hot loops that would otherwise call
+// DaysInMonth repeatedly. The array is a copy that callers may modify.
+func MonthLengths(year int) [14]int {
+	lengths := commonMonthLengths
+	if IsLeap(year) {
+		lengths[13] = 6
+	}
+	return lengths
+}
+
+// EpagomenalDays returns the number of epagomenal days (the days of Pagume)
+// in the given year: 6 in a leap year, 5 otherwise.
+func EpagomenalDays(year int) int {
+	return DaysInMonth(year, 13)
+}
+
+// IsEpagomenal reports whether the date falls in Pagume, the epagomenal days
+// at the end of the year.
+func (d EtDate) IsEpagomenal() bool {
+	return d.Month == 13
+}
+
+// ValidateOptions relaxes the checks made by ValidateWith.
+type ValidateOptions struct {
+	// AllowNonPositiveYear accepts year 0 and negative years, as found in
+	// Amete Alem counting and some historical datasets. Such years are
+	// never leap years.
+	AllowNonPositiveYear bool
+}
+
+// Validate checks if the EtDate is valid. A failure is a *ValidationError
+// naming the offending component.
 func (d EtDate) Validate() error {
-	if d.Year <= 0 {
-		return errors.New("year must be positive")
+	return ValidateFields(d.Year, d.Month, d.Day)
+}
+
+// ValidateWith checks if the EtDate is valid, applying the relaxations in
+// opts. Month and day are always checked.
+func (d EtDate) ValidateWith(opts ValidateOptions) error {
+	return validateFields(d.Year, d.Month, d.Day, opts)
+}
+
+// ValidateFields makes the same checks as EtDate.Validate on loose
+// components, for raw input that has not been put into an EtDate yet.
+func ValidateFields(year, month, day int) error {
+	return validateFields(year, month, day, ValidateOptions{})
+}
+
+func validateFields(year, month, day int, opts ValidateOptions) error {
+	if year <= 0 && !opts.AllowNonPositiveYear {
+		return newValidationError("year", year, ErrInvalidYear)
 	}
-	if d.Month < 1 || d.Month > 13 {
-		return errors.New("month must be between 1 and 13")
+	if month < 1 || month > 13 {
+		return newValidationError("month", month, ErrInvalidMonth)
 	}
-	maxDay := DaysInMonth(d.Year, d.Month)
-	if d.Day < 1 || d.Day > maxDay {
-		return errors.New("day out of range for month")
+	if day < 1 || day > DaysInMonth(year, month) {
+		return newValidationError("day", day, ErrInvalidDay)
 	}
 	return nil
 }
 
-// ToJDN converts an Ethiopian date to Julian Day Number.
-func (d EtDate) ToJDN() (int, error) {
+// Coerce returns the nearest valid date to d by clamping each component into
+// range: the year to at least 1, the month to 1-13 and the day to the
+// length of the resulting month. Pagume 6 in a common year becomes Pagume 5
+// and month 14 becomes Pagume. Unlike arithmetic such as AddDays, nothing
+// overflows into the next month or year.
+func (d EtDate) Coerce() EtDate {
+	d.Year = max(d.Year, 1)
+	d.Month = min(max(d.Month, 1), 13)
+	d.Day = min(max(d.Day, 1), DaysInMonth(d.Year, d.Month))
+	return d
+}
+
+// FromParts builds a validated date from int32 components, as carried by
+// protobuf messages.
+func FromParts(year, month, day int32) (EtDate, error) {
+	d := EtDate{Year: int(year), Month: int(month), Day: int(day)}
+	if err := d.Validate(); err != nil {
+		return EtDate{}, err
+	}
+	return d, nil
+}
+
+// NormalizePartial completes a partially entered date, such as one from a
+// form that only asks for the year and month. A zero month and a zero day
+// both default to 1, so a year alone means Meskerem 1 of that year. A day
+// without a month is rejected with ErrInvalidMonth, as is any other invalid
+// component.
+func NormalizePartial(year, month, day int) (EtDate, error) {
+	if month == 0 {
+		if day != 0 {
+			return EtDate{}, ErrInvalidMonth
+		}
+		month = 1
+	}
+	if day == 0 {
+		day = 1
+	}
+	d := EtDate{Year: year, Month: month, Day: day}
+	if err := d.Validate(); err != nil {
+		return EtDate{}, err
+	}
+	return d, nil
+}
+
+// Parts returns the date's components as int32 values. Years outside the
+// int32 range are truncated.
+func (d EtDate) Parts() (int32, int32, int32) {
+	return int32(d.Year), int32(d.Month), int32(d.Day)
+}
+
+// Map returns the date's components keyed by "year", "month" and "day", for
+// templating engines that look fields up by name.
+func (d EtDate) Map() map[string]int {
+	return map[string]int{"year": d.Year, "month": d.Month, "day": d.Day}
+}
+
+// WithYear returns a copy of d with the year replaced. The result is not
+// validated; call Validate before relying on it.
+func (d EtDate) WithYear(y int) EtDate {
+	d.Year = y
+	return d
+}
+
+// WithMonth returns a copy of d with the month replaced. The result is not
+// validated; call Validate before relying on it.
+func (d EtDate) WithMonth(m int) EtDate {
+	d.Month = m
+	return d
+}
+
+// WithDay returns a copy of d with the day replaced. The result is not
+// validated; call Validate before relying on it.
+func (d EtDate) WithDay(day int) EtDate {
+	d.Day = day
+	return d
+}
+
+// DaysRemainingInMonth returns the number of days left in the date's month
+// after d, which is 0 on the last day of the month.
+func (d EtDate) DaysRemainingInMonth() (int, error) {
 	if err := d.Validate(); err != nil {
 		return 0, err
 	}
-	y := d.Year
-	m := d.Month
-	day := d.Day
-	return jdOffset + 365*(y-1) + (y / 4) + 30*(m-1) + day - 1, nil
+	return DaysInMonth(d.Year, d.Month) - d.Day, nil
 }
 
-// JDNToEt converts a Julian Day Number to an Ethiopian Calendar date.
-func JDNToEt(jdn int) (EtDate, error) {
-	if jdn < jdOffset {
-		return EtDate{}, errors.New("jdn before Ethiopian epoch")
+// Ordinal returns the day of the year of the date, from 1 for Meskerem 1 to
+// 365 or 366 for the last day of Pagume.
+func (d EtDate) Ordinal() (int, error) {
+	if err := d.Validate(); err != nil {
+		return 0, err
+	}
+	return 30*(d.Month-1) + d.Day, nil
+}
+
+// YearFraction returns how far through its year d is, as its day of the
+// year divided by the length of the year. It is 1/365 or 1/366 on Meskerem 1
+// and exactly 1 on the last day of Pagume.
+func (d EtDate) YearFraction() (float64, error) {
+	ordinal, err := d.Ordinal()
+	if err != nil {
+		return 0, err
 	}
+	return float64(ordinal) / float64(daysInYear(d.Year)), nil
+}
 
-	// Calculate days since the Ethiopian epoch
-	fixed := jdn - jdOffset
+// FromOrdinal returns the date with the given day of the year (1-366).
+func FromOrdinal(year, ordinal int) (EtDate, error) {
+	if year <= 0 {
+		return EtDate{}, ErrInvalidYear
+	}
+	if ordinal < 1 || ordinal > daysInYear(year) {
+		return EtDate{}, ErrInvalidOrdinal
+	}
+	return EtDate{Year: year, Month: (ordinal-1)/30 + 1, Day: (ordinal-1)%30 + 1}, nil
+}
 
-	// Estimate year: account for 365 days per year + leap days (1 every 4 years)
-	year := fixed / 365
-	yearStartJDN, err := (EtDate{Year: year, Month: 1, Day: 1}).ToJDN()
+// DaysThroughEndOfMonth returns the number of days from d through the last
+// day of its month, counting both, so it is 1 on the last day. It is one
+// more than DaysRemainingInMonth, which does not count d itself.
+func (d EtDate) DaysThroughEndOfMonth() (int, error) {
+	remaining, err := d.DaysRemainingInMonth()
 	if err != nil {
-		return EtDate{}, err
+		return 0, err
 	}
-	if jdn < yearStartJDN {
-		year--
-		yearStartJDN, err = (EtDate{Year: year, Month: 1, Day: 1}).ToJDN()
-		if err != nil {
-			return EtDate{}, err
-		}
+	return remaining + 1, nil
+}
+
+// DaysSinceNewYear returns the number of days from the preceding Meskerem 1
+// to d: 0 on Meskerem 1 and up to 365 on Pagume 6 of a leap year. It is
+// Ordinal minus one, the zero-based count that day arithmetic needs.
+func (d EtDate) DaysSinceNewYear() (int, error) {
+	ordinal, err := d.Ordinal()
+	if err != nil {
+		return 0, err
 	}
+	return ordinal - 1, nil
+}
 
-	// Calculate days since the start of the Ethiopian year
-	daysSinceYearStart := jdn - yearStartJDN
-	if daysSinceYearStart < 0 {
-		return EtDate{}, errors.New("invalid day calculation")
+// DaysUntilNewYear returns the number of days from d to the next Meskerem 1,
+// or 0 if d is Meskerem 1. The count includes the 5 or 6 days of Pagume.
+func (d EtDate) DaysUntilNewYear() (int, error) {
+	ordinal, err := d.Ordinal()
+	if err != nil {
+		return 0, err
+	}
+	if ordinal == 1 {
+		return 0, nil
 	}
+	return daysInYear(d.Year) - ordinal + 1, nil
+}
 
-	// Calculate month and day
-	month := daysSinceYearStart/30 + 1
-	day := daysSinceYearStart%30 + 1
-	if month > 13 {
-		month = 1
-		year++
-		day = daysSinceYearStart - 12*30 + 1
+// ToJDN converts an Ethiopian date to Julian Day Number. The JDN is the
+// integer day count of the civil date: astronomically that day begins at
+// noon, so the civil day d runs from Julian Date JDN-0.5 (midnight) to
+// JDN+0.5. All JDNs in this package follow this convention.
+//
+// ToJDN uses the epoch of DefaultCalendar; use Calendar.ToJDN for another.
+func (d EtDate) ToJDN() (int, error) {
+	return DefaultCalendar.ToJDN(d)
+}
+
+// EpochDay returns the number of days from the Ethiopian epoch, Meskerem 1
+// of year 1, to d; the epoch itself is day 0. It equals the JDN minus the
+// epoch's JDN.
+func (d EtDate) EpochDay() (int, error) {
+	return DefaultCalendar.EpochDay(d)
+}
+
+// FromEpochDay returns the date n days after the Ethiopian epoch. It returns
+// ErrBeforeEpoch for negative n.
+func FromEpochDay(n int) (EtDate, error) {
+	return DefaultCalendar.FromEpochDay(n)
+}
+
+// JDNDiff returns the number of days from b to a, that is the
+// Julian Day Number of a minus that of b. It fails if either date is invalid.
+func JDNDiff(a, b EtDate) (int, error) {
+	ja, err := a.ToJDN()
+	if err != nil {
+		return 0, err
 	}
+	jb, err := b.ToJDN()
+	if err != nil {
+		return 0, err
+	}
+	return ja - jb, nil
+}
 
-	d := EtDate{Year: year, Month: int(month), Day: int(day)}
-	if err := d.Validate(); err != nil {
-		return EtDate{}, err
+// Between returns the number of days from from to to. The result is positive
+// when to is after from and negative when it is before.
+func Between(from, to EtDate) (days int, err error) {
+	return JDNDiff(to, from)
+}
+
+// Sub returns the signed number of days from other to d, so it is negative
+// when other is later than d. It is the method form of Between(other, d).
+func (d EtDate) Sub(other EtDate) (int, error) {
+	return Between(other, d)
+}
+
+// IsDayAfter reports whether d is exactly one day after other.
+func (d EtDate) IsDayAfter(other EtDate) (bool, error) {
+	diff, err := JDNDiff(d, other)
+	if err != nil {
+		return false, err
 	}
-	return d, nil
+	return diff == 1, nil
 }
 
-// GregorianToJDN converts a Gregorian date to Julian Day Number.
-func GregorianToJDN(year, month, day int) (int, error) {
+// IsDayBefore reports whether d is exactly one day before other.
+func (d EtDate) IsDayBefore(other EtDate) (bool, error) {
+	return other.IsDayAfter(d)
+}
+
+// JulianDate returns the fractional astronomical Julian Date at midnight at
+// the start of the date, which is ToJDN minus 0.5.
+func (d EtDate) JulianDate() (float64, error) {
+	jdn, err := d.ToJDN()
+	if err != nil {
+		return 0, err
+	}
+	return float64(jdn) - 0.5, nil
+}
+
+// yearStartJDN returns the Julian Day Number of Meskerem 1 of the given
+// Ethiopian year in DefaultCalendar without validating it.
+func yearStartJDN(year int) int {
+	return DefaultCalendar.yearStartJDN(year)
+}
+
+// JDNToEt converts a Julian Day Number to an Ethiopian Calendar date.
+// Conversions are exact for every day from the epoch, Meskerem 1 of year 1
+// (27 August 8 CE in the proleptic Gregorian calendar), onwards; earlier
+// JDNs return ErrBeforeEpoch. JDNToEt uses the epoch of DefaultCalendar;
+// use Calendar.JDNToEt for another.
+func JDNToEt(jdn int) (EtDate, error) {
+	return DefaultCalendar.JDNToEt(jdn)
+}
+
+// jdnToEt converts a Julian Day Number on or after the epoch to an
+// Ethiopian Calendar date without checking it.
+func jdnToEt(jdn int) EtDate {
+	return DefaultCalendar.jdnToEt(jdn)
+}
+
+// gregorianDaysInMonth returns the number of days in the given Gregorian
+// month, applying the century rules for February. month must be 1-12.
+func gregorianDaysInMonth(year, month int) int {
+	if month == 2 && year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+		return 29
+	}
+	return gregorianMonthDays[month-1]
+}
+
+// validateGregorian checks that year, month and day form a valid proleptic
+// Gregorian date.
+func validateGregorian(year, month, day int) error {
 	if year == 0 {
-		return 0, errors.New("no year 0 in Gregorian")
+		return ErrInvalidGregorianYear
 	}
 	if month < 1 || month > 12 {
-		return 0, errors.New("month must be between 1 and 12")
+		return ErrInvalidGregorianMonth
 	}
 	if day < 1 {
-		return 0, errors.New("day must be positive")
+		return ErrInvalidGregorianDay
 	}
-	// Basic validation for day of month
-	daysInMonth := []int{31, 28, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
-	if month == 2 && (year%4 == 0 && (year%100 != 0 || year%400 == 0)) {
-		daysInMonth[1] = 29
+	if day > gregorianDaysInMonth(year, month) {
+		return ErrInvalidGregorianDay
 	}
-	if day > daysInMonth[month-1] {
-		return 0, errors.New("day is out of range for the given month")
+	return nil
+}
+
+// GregorianToJDN converts a Gregorian date to Julian Day Number.
+func GregorianToJDN(year, month, day int) (int, error) {
+	if err := validateGregorian(year, month, day); err != nil {
+		return 0, err
 	}
 
 	a := (14 - month) / 12
@@ -150,51 +517,264 @@ func JDNToGregorian(jdn int) (year, month, day int, err error) {
 	month = m + 3 - 12*(m/10)
 	year = 100*b + d - 4800 + m/10
 	if year <= 0 {
-		return 0, 0, 0, errors.New("invalid Gregorian year")
+		return 0, 0, 0, ErrInvalidGregorianYear
 	}
 	return year, month, day, nil
 }
 
-// ToGregorian converts an Ethiopian Calendar date to a Gregorian date.
+// ToGregorian converts an Ethiopian Calendar date to a Gregorian date using
+// the epoch of DefaultCalendar.
 func (d EtDate) ToGregorian() (int, int, int, error) {
-	jdn, err := d.ToJDN()
+	return DefaultCalendar.ToGregorian(d)
+}
+
+// GregorianISO returns the equivalent Gregorian date in YYYY-MM-DD form.
+func (d EtDate) GregorianISO() (string, error) {
+	gy, gm, gd, err := d.ToGregorian()
 	if err != nil {
-		return 0, 0, 0, err
+		return "", err
 	}
-	return JDNToGregorian(jdn)
+	return fmt.Sprintf("%04d-%02d-%02d", gy, gm, gd), nil
 }
 
-// FromGregorian converts a Gregorian date to an Ethiopian Calendar date.
+// FromGregorian converts a Gregorian date to an Ethiopian Calendar date
+// using the epoch of DefaultCalendar.
 func FromGregorian(year, month, day int) (EtDate, error) {
-	// Validate input using time package for precise Gregorian date validation
-	_, err := time.Parse("2006-01-02", fmt.Sprintf("%04d-%02d-%02d", year, month, day))
+	return DefaultCalendar.FromGregorian(year, month, day)
+}
+
+// FromGregorianBatch converts each Gregorian date to an Ethiopian Calendar
+// date. The returned slices are parallel to dates: an invalid entry yields a
+// zero EtDate and a non-nil error at its index without affecting the others.
+func FromGregorianBatch(dates []GregorianDate) ([]EtDate, []error) {
+	results := make([]EtDate, len(dates))
+	errs := make([]error, len(dates))
+	for i, g := range dates {
+		results[i], errs[i] = FromGregorian(g.Year, g.Month, g.Day)
+	}
+	return results, errs
+}
+
+// EthiopianDateOfGregorianNewYear returns the Ethiopian date of January 1 of
+// the given Gregorian year.
+func EthiopianDateOfGregorianNewYear(gregYear int) (EtDate, error) {
+	return FromGregorian(gregYear, 1, 1)
+}
+
+// GregorianDateOfEthiopianNewYear returns the Gregorian date of Meskerem 1 of
+// the given Ethiopian year.
+func GregorianDateOfEthiopianNewYear(etYear int) (GregorianDate, error) {
+	gy, gm, gd, err := (EtDate{Year: etYear, Month: 1, Day: 1}).ToGregorian()
 	if err != nil {
-		return EtDate{}, errors.New("invalid Gregorian date: " + err.Error())
+		return GregorianDate{}, err
 	}
+	return GregorianDate{Year: gy, Month: gm, Day: gd}, nil
+}
 
-	jdn, err := GregorianToJDN(year, month, day)
+// GregorianMonthsSpanned returns the Gregorian months the Ethiopian year
+// overlaps, typically September through the following September. Each entry
+// is the first day of that month within the Ethiopian year, so the first
+// entry is the Gregorian date of Meskerem 1 and the rest have Day 1.
+func GregorianMonthsSpanned(etYear int) ([]GregorianDate, error) {
+	first, err := GregorianDateOfEthiopianNewYear(etYear)
 	if err != nil {
-		return EtDate{}, err
+		return nil, err
+	}
+	last := EtDate{Year: etYear, Month: 13, Day: DaysInMonth(etYear, 13)}
+	ly, lm, _, err := last.ToGregorian()
+	if err != nil {
+		return nil, err
+	}
+
+	months := []GregorianDate{first}
+	y, m := first.Year, first.Month
+	for y != ly || m != lm {
+		m++
+		if m > 12 {
+			m = 1
+			y++
+		}
+		months = append(months, GregorianDate{Year: y, Month: m, Day: 1})
+	}
+	return months, nil
+}
+
+// GregorianOfEthiopianMonthDay returns the Gregorian date in gregYear on
+// which the Ethiopian month and day occur. Each Gregorian year overlaps two
+// Ethiopian years, so the month and day usually occur exactly once. Rarely,
+// near the turn of the Gregorian year, they occur twice (e.g. Tahsas 22 is
+// both 1 January and 31 December 2028); the earlier date is returned then.
+// Pagume 6 occurs only in Ethiopian leap years, so some Gregorian years have
+// no occurrence and an error is returned.
+func GregorianOfEthiopianMonthDay(etMonth, etDay, gregYear int) (GregorianDate, error) {
+	if etMonth < 1 || etMonth > 13 {
+		return GregorianDate{}, ErrInvalidMonth
+	}
+	// Year 3 is a leap year, so Pagume 6 is accepted here.
+	if etDay < 1 || etDay > DaysInMonth(3, etMonth) {
+		return GregorianDate{}, ErrInvalidDay
+	}
+	// The Ethiopian year starting in September of gregYear-1 comes first.
+	for _, etYear := range []int{gregYear - 8, gregYear - 7} {
+		d := EtDate{Year: etYear, Month: etMonth, Day: etDay}
+		if d.Validate() != nil {
+			continue
+		}
+		gy, gm, gd, err := d.ToGregorian()
+		if err != nil {
+			return GregorianDate{}, err
+		}
+		if gy == gregYear {
+			return GregorianDate{Year: gy, Month: gm, Day: gd}, nil
+		}
 	}
-	return JDNToEt(jdn)
+	return GregorianDate{}, errors.New("month and day do not occur in the Gregorian year")
 }
 
-// Format formats the Ethiopian date according to the specified layout.
+// Format formats the Ethiopian date according to the specified layout. The
+// layout tokens are YYYY (year), GeezYYYY (year in Ge'ez numerals), MM and
+// M (month with and without padding), DD and D (day with and without
+// padding), Do (day with an ordinal suffix), Month and Mon (full and
+// abbreviated month name) and Era. At each position the longest token wins,
+// so "Month" is never read as "Mon" or "M". Text inside square brackets is
+// copied literally without the brackets, so "[Do] Do" gives "Do 1st"; an
+// unclosed '[' is copied as is. Names are in the locale of DefaultCalendar,
+// English unless it is changed.
 func (d EtDate) Format(layout string) string {
-	str := strings.ReplaceAll(layout, "YYYY", fmt.Sprintf("%04d", d.Year))
-	str = strings.ReplaceAll(str, "MM", fmt.Sprintf("%02d", d.Month))
-	str = strings.ReplaceAll(str, "DD", fmt.Sprintf("%02d", d.Day))
-	str = strings.ReplaceAll(str, "Month", monthNames[d.Month])
-	return str
+	return DefaultCalendar.Format(d, layout)
 }
 
-// AddDays adds or subtracts the specified number of days to the Ethiopian date.
-func (d EtDate) AddDays(days int) (EtDate, error) {
-	jdn, err := d.ToJDN()
-	if err != nil {
-		return EtDate{}, err
+// DefaultLayout is the layout used by FormatDefault, e.g. "01 Meskerem 2016".
+const DefaultLayout = "DD Month YYYY"
+
+// FormatDefault formats the date with DefaultLayout.
+func (d EtDate) FormatDefault() string {
+	return d.Format(DefaultLayout)
+}
+
+// FormatLocale formats the Ethiopian date like Format, rendering the Month and
+// Era tokens in the given locale. Unknown locales fall back to English.
+func (d EtDate) FormatLocale(layout, locale string) string {
+	if locale == LocaleAmharic {
+		return d.format(layout, amharicMonthNames, amharicMonthNames, eraAmharic)
+	}
+	return d.format(layout, monthNames, shortMonthNames, eraEnglish)
+}
+
+// formatTokens lists the layout tokens longest first, so the first one that
+// matches at a position is the longest match.
+var formatTokens = []string{"GeezYYYY", "Month", "YYYY", "Mon", "Era", "MM", "DD", "Do", "M", "D"}
+
+// format scans layout once from left to right, replacing the longest token
+// at each position. Substituted text is never scanned again, so a month name
+// containing "M" or "D" is emitted unchanged.
+func (d EtDate) format(layout string, names, short []string, era string) string {
+	var b strings.Builder
+	for i := 0; i < len(layout); {
+		if layout[i] == '[' {
+			if end := strings.IndexByte(layout[i+1:], ']'); end >= 0 {
+				b.WriteString(layout[i+1 : i+1+end])
+				i += end + 2
+				continue
+			}
+		}
+		token := layoutToken(layout[i:])
+		if token == "" {
+			b.WriteByte(layout[i])
+			i++
+			continue
+		}
+		b.WriteString(d.formatToken(token, names, short, era))
+		i += len(token)
+	}
+	return b.String()
+}
+
+// layoutToken returns the longest layout token s starts with, or "".
+func layoutToken(s string) string {
+	for _, t := range formatTokens {
+		if strings.HasPrefix(s, t) {
+			return t
+		}
+	}
+	return ""
+}
+
+// formatToken returns the text for a single layout token.
+func (d EtDate) formatToken(token string, names, short []string, era string) string {
+	switch token {
+	case "YYYY":
+		return fmt.Sprintf("%04d", d.Year)
+	case "GeezYYYY":
+		if year, err := ToGeez(d.Year); err == nil {
+			return year
+		}
+		return fmt.Sprintf("%04d", d.Year)
+	case "MM":
+		return fmt.Sprintf("%02d", d.Month)
+	case "M":
+		return strconv.Itoa(d.Month)
+	case "DD":
+		return fmt.Sprintf("%02d", d.Day)
+	case "D":
+		return strconv.Itoa(d.Day)
+	case "Do":
+		return ordinalDay(d.Day)
+	case "Era":
+		return era
+	}
+	if d.Month < 1 || d.Month > 13 {
+		return Month(d.Month).String()
+	}
+	if token == "Mon" {
+		return short[d.Month]
+	}
+	return names[d.Month]
+}
+
+// ordinalDay returns day with its English ordinal suffix (1st, 2nd, 11th).
+func ordinalDay(day int) string {
+	suffix := "th"
+	if day%100 < 11 || day%100 > 13 {
+		switch day % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
 	}
-	return JDNToEt(jdn + days)
+	return strconv.Itoa(day) + suffix
+}
+
+// Era returns the label of the era the date is counted in, in the locale of
+// DefaultCalendar, so it matches the Era token of Format.
+func (d EtDate) Era() string {
+	return d.EraLocale(DefaultCalendar.Locale)
+}
+
+// EraLocale returns the era label in the given locale, as rendered by the Era
+// token of FormatLocale: "ዓ.ም." for LocaleAmharic and "EC" otherwise.
+func (d EtDate) EraLocale(locale string) string {
+	if locale == LocaleAmharic {
+		return eraAmharic
+	}
+	return eraEnglish
+}
+
+// AddDays adds or subtracts the specified number of days to the Ethiopian
+// date. A result before Meskerem 1 of year 1 is an error wrapping
+// ErrBeforeEpoch.
+func (d EtDate) AddDays(days int) (EtDate, error) {
+	return DefaultCalendar.AddDays(d, days)
+}
+
+// AddDaysUnchecked is like AddDays but skips validation of d and of the
+// result. It is only safe for dates already known to be valid and for shifts
+// that stay on or after 1 Meskerem 1; otherwise the result is meaningless.
+func (d EtDate) AddDaysUnchecked(days int) EtDate {
+	return jdnToEt(yearStartJDN(d.Year) + 30*(d.Month-1) + d.Day - 1 + days)
 }
 
 // AddMonths adds or subtracts the specified number of months to the Ethiopian date.
@@ -216,6 +796,67 @@ func (d EtDate) AddMonths(months int) EtDate {
 	return newDate
 }
 
+// MonthsAgo returns the date n months before d, equivalent to
+// d.AddMonths(-n). A day that does not exist in the resulting month is
+// clamped to its last day, so landing on Pagume of a common year gives day 5.
+func (d EtDate) MonthsAgo(n int) EtDate {
+	return d.AddMonths(-n)
+}
+
+// MonthSpan returns the number of calendar months from a's month to b's
+// month, ignoring the days: (b.Year-a.Year)*13 + (b.Month-a.Month). It is
+// negative when b's month is before a's. Pagume counts as a month like any
+// other. Unlike AgeInMonths, which only counts a month once its day is
+// reached, Meskerem 30 to Tikimt 1 is a span of 1.
+func MonthSpan(a, b EtDate) int {
+	return (b.Year-a.Year)*13 + (b.Month - a.Month)
+}
+
+// AgeInMonths returns the number of whole months from d, such as a birth
+// date, to asOf. A month is complete once AddMonths reaches a day on or
+// before asOf, so someone born on Megabit 30 completes a month on the last
+// day of Pagume. It returns ErrInvalidRange if asOf is before d.
+func (d EtDate) AgeInMonths(asOf EtDate) (int, error) {
+	days, err := Between(d, asOf)
+	if err != nil {
+		return 0, err
+	}
+	if days < 0 {
+		return 0, ErrInvalidRange
+	}
+	months := (asOf.Year-d.Year)*13 + asOf.Month - d.Month
+	// The candidate is valid because AddMonths clamps the day.
+	if past, _ := JDNDiff(d.AddMonths(months), asOf); past > 0 {
+		months--
+	}
+	return months, nil
+}
+
+// LastOccurrenceOf returns the most recent date on or before d that falls
+// on the given month and day, such as the last anniversary of an event.
+// Pagume 6 only exists in leap years, so for it the result can be up to
+// four years back. It returns ErrBeforeEpoch if there is no such date from
+// year 1 onwards.
+func (d EtDate) LastOccurrenceOf(month, day int) (EtDate, error) {
+	if err := d.Validate(); err != nil {
+		return EtDate{}, err
+	}
+	// Check month and day against a leap year, where every pair exists.
+	if err := ValidateFields(3, month, day); err != nil {
+		return EtDate{}, err
+	}
+	for year := d.Year; year >= 1; year-- {
+		c := EtDate{Year: year, Month: month, Day: day}
+		if c.Validate() != nil {
+			continue
+		}
+		if days, _ := Between(c, d); days >= 0 {
+			return c, nil
+		}
+	}
+	return EtDate{}, ErrBeforeEpoch
+}
+
 // AddYears adds or subtracts the specified number of years to the Ethiopian date.
 func (d EtDate) AddYears(years int) EtDate {
 	newDate := EtDate{Year: d.Year + years, Month: d.Month, Day: d.Day}
@@ -1,6 +1,11 @@
 package ethiopiancalendar
 
-import "testing"
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
 
 func TestIsLeap(t *testing.T) {
 	tests := []struct {
@@ -69,3 +74,1320 @@ func TestAddDays(t *testing.T) {
 		t.Errorf("Expected 2016-01-11, got %d-%d-%d", future.Year, future.Month, future.Day)
 	}
 }
+
+func TestFromGregorianYearRange(t *testing.T) {
+	tests := []struct {
+		gy, gm, gd int
+		want       EtDate
+	}{
+		{9999, 12, 31, EtDate{Year: 9992, Month: 2, Day: 21}},
+		{100, 1, 1, EtDate{Year: 92, Month: 5, Day: 7}},
+	}
+
+	for _, tt := range tests {
+		et, err := FromGregorian(tt.gy, tt.gm, tt.gd)
+		if err != nil {
+			t.Errorf("FromGregorian(%d, %d, %d) returned error: %v", tt.gy, tt.gm, tt.gd, err)
+			continue
+		}
+		if et != tt.want {
+			t.Errorf("FromGregorian(%d, %d, %d) = %+v, want %+v", tt.gy, tt.gm, tt.gd, et, tt.want)
+		}
+		gy, gm, gd, err := et.ToGregorian()
+		if err != nil {
+			t.Errorf("%+v.ToGregorian() returned error: %v", et, err)
+			continue
+		}
+		if gy != tt.gy || gm != tt.gm || gd != tt.gd {
+			t.Errorf("round trip of %d-%d-%d gave %d-%d-%d", tt.gy, tt.gm, tt.gd, gy, gm, gd)
+		}
+	}
+}
+
+func TestFromGregorianInvalid(t *testing.T) {
+	if _, err := FromGregorian(2023, 2, 29); err == nil {
+		t.Error("Expected error for 2023-02-29")
+	}
+	if _, err := FromGregorian(0, 1, 1); err == nil {
+		t.Error("Expected error for year 0")
+	}
+}
+
+func BenchmarkFromGregorian(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := FromGregorian(2023, 9, 12); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkToGregorian(b *testing.B) {
+	et := EtDate{Year: 2016, Month: 1, Day: 1}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, _, _, err := et.ToGregorian(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkAddDays(b *testing.B) {
+	et := EtDate{Year: 2016, Month: 1, Day: 1}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := et.AddDays(400); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkJDNToEt(b *testing.B) {
+	jdn, err := (EtDate{Year: 2016, Month: 7, Day: 15}).ToJDN()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := JDNToEt(jdn); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestJDNRoundTrip(t *testing.T) {
+	// Walk every day of the first few four-year cycles and around a modern
+	// leap year so each year boundary is crossed in both Pagume lengths.
+	for _, start := range []EtDate{{Year: 1, Month: 1, Day: 1}, {Year: 2012, Month: 1, Day: 1}} {
+		jdn, err := start.ToJDN()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 3*1461; i++ {
+			et, err := JDNToEt(jdn + i)
+			if err != nil {
+				t.Fatalf("JDNToEt(%d) returned error: %v", jdn+i, err)
+			}
+			back, err := et.ToJDN()
+			if err != nil {
+				t.Fatalf("%+v.ToJDN() returned error: %v", et, err)
+			}
+			if back != jdn+i {
+				t.Fatalf("JDNToEt(%d) = %+v, which converts back to %d", jdn+i, et, back)
+			}
+		}
+	}
+}
+
+func FuzzJDNRoundTrip(f *testing.F) {
+	f.Add(jdOffset)
+	f.Add(2460200)
+	f.Add(5373484)
+	f.Fuzz(func(t *testing.T, jdn int) {
+		// JDNToEt multiplies the day count by 4, so stay clear of int32
+		// overflow on 32-bit targets.
+		if jdn < jdOffset || jdn > math.MaxInt32/4 {
+			t.Skip()
+		}
+		et, err := JDNToEt(jdn)
+		if err != nil {
+			t.Fatalf("JDNToEt(%d) returned error: %v", jdn, err)
+		}
+		back, err := et.ToJDN()
+		if err != nil {
+			t.Fatalf("%+v.ToJDN() returned error: %v", et, err)
+		}
+		if back != jdn {
+			t.Fatalf("JDNToEt(%d) = %+v, which converts back to %d", jdn, et, back)
+		}
+	})
+}
+
+func TestEthiopianDateOfGregorianNewYear(t *testing.T) {
+	tests := []struct {
+		gregYear int
+		want     EtDate
+	}{
+		{2024, EtDate{Year: 2016, Month: 4, Day: 22}},
+		{2025, EtDate{Year: 2017, Month: 4, Day: 23}},
+	}
+
+	for _, tt := range tests {
+		got, err := EthiopianDateOfGregorianNewYear(tt.gregYear)
+		if err != nil {
+			t.Errorf("EthiopianDateOfGregorianNewYear(%d) returned error: %v", tt.gregYear, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("EthiopianDateOfGregorianNewYear(%d) = %+v, want %+v", tt.gregYear, got, tt.want)
+		}
+	}
+}
+
+func TestGregorianDateOfEthiopianNewYear(t *testing.T) {
+	tests := []struct {
+		etYear int
+		want   GregorianDate
+	}{
+		{2016, GregorianDate{Year: 2023, Month: 9, Day: 12}},
+		{2017, GregorianDate{Year: 2024, Month: 9, Day: 11}},
+	}
+
+	for _, tt := range tests {
+		got, err := GregorianDateOfEthiopianNewYear(tt.etYear)
+		if err != nil {
+			t.Errorf("GregorianDateOfEthiopianNewYear(%d) returned error: %v", tt.etYear, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("GregorianDateOfEthiopianNewYear(%d) = %+v, want %+v", tt.etYear, got, tt.want)
+		}
+	}
+
+	if _, err := GregorianDateOfEthiopianNewYear(0); err == nil {
+		t.Error("Expected error for Ethiopian year 0")
+	}
+}
+
+func TestWithFields(t *testing.T) {
+	et := EtDate{Year: 2016, Month: 5, Day: 20}
+
+	if got := et.WithYear(2017); got != (EtDate{Year: 2017, Month: 5, Day: 20}) {
+		t.Errorf("WithYear(2017) = %+v", got)
+	}
+	if got := et.WithMonth(13); got != (EtDate{Year: 2016, Month: 13, Day: 20}) {
+		t.Errorf("WithMonth(13) = %+v", got)
+	}
+	if got := et.WithDay(1); got != (EtDate{Year: 2016, Month: 5, Day: 1}) {
+		t.Errorf("WithDay(1) = %+v", got)
+	}
+	if et != (EtDate{Year: 2016, Month: 5, Day: 20}) {
+		t.Errorf("receiver was modified: %+v", et)
+	}
+}
+
+func TestFromGregorianBatch(t *testing.T) {
+	dates := []GregorianDate{
+		{Year: 2023, Month: 9, Day: 12},
+		{Year: 2023, Month: 2, Day: 30},
+		{Year: 2024, Month: 1, Day: 1},
+	}
+	got, errs := FromGregorianBatch(dates)
+
+	if len(got) != len(dates) || len(errs) != len(dates) {
+		t.Fatalf("Expected %d results and errors, got %d and %d", len(dates), len(got), len(errs))
+	}
+	if errs[0] != nil || got[0] != (EtDate{Year: 2016, Month: 1, Day: 1}) {
+		t.Errorf("Entry 0: got %+v, %v", got[0], errs[0])
+	}
+	if errs[1] == nil || got[1] != (EtDate{}) {
+		t.Errorf("Entry 1: expected error and zero date, got %+v, %v", got[1], errs[1])
+	}
+	if errs[2] != nil || got[2] != (EtDate{Year: 2016, Month: 4, Day: 22}) {
+		t.Errorf("Entry 2: got %+v, %v", got[2], errs[2])
+	}
+}
+
+func TestFormatEra(t *testing.T) {
+	et := EtDate{Year: 2016, Month: 1, Day: 1}
+
+	if got := et.Era(); got != "EC" {
+		t.Errorf("Era() = %q, want %q", got, "EC")
+	}
+	if got := et.Format("DD Month YYYY Era"); got != "01 Meskerem 2016 EC" {
+		t.Errorf("Format = %q", got)
+	}
+
+	tests := []struct {
+		locale string
+		want   string
+	}{
+		{LocaleEnglish, "01 Meskerem 2016 EC"},
+		{LocaleAmharic, "01 መስከረም 2016 ዓ.ም."},
+		{"fr", "01 Meskerem 2016 EC"},
+	}
+	for _, tt := range tests {
+		if got := et.FormatLocale("DD Month YYYY Era", tt.locale); got != tt.want {
+			t.Errorf("FormatLocale(%q) = %q, want %q", tt.locale, got, tt.want)
+		}
+		if got, want := et.EraLocale(tt.locale), et.FormatLocale("Era", tt.locale); got != want {
+			t.Errorf("EraLocale(%q) = %q, want %q", tt.locale, got, want)
+		}
+	}
+
+	defer func(locale string) { DefaultCalendar.Locale = locale }(DefaultCalendar.Locale)
+	DefaultCalendar.Locale = LocaleAmharic
+	if got := et.Era(); got != "ዓ.ም." {
+		t.Errorf("Era() with an Amharic default = %q, want %q", got, "ዓ.ም.")
+	}
+}
+
+func TestJDNDiff(t *testing.T) {
+	tests := []struct {
+		a, b EtDate
+		want int
+	}{
+		{EtDate{Year: 2016, Month: 1, Day: 11}, EtDate{Year: 2016, Month: 1, Day: 1}, 10},
+		{EtDate{Year: 2016, Month: 1, Day: 1}, EtDate{Year: 2016, Month: 1, Day: 11}, -10},
+		{EtDate{Year: 2016, Month: 1, Day: 1}, EtDate{Year: 2015, Month: 13, Day: 6}, 1},
+		{EtDate{Year: 2016, Month: 1, Day: 1}, EtDate{Year: 2015, Month: 1, Day: 1}, 366},
+	}
+
+	for _, tt := range tests {
+		got, err := JDNDiff(tt.a, tt.b)
+		if err != nil {
+			t.Errorf("JDNDiff(%+v, %+v) returned error: %v", tt.a, tt.b, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("JDNDiff(%+v, %+v) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+
+	if _, err := JDNDiff(EtDate{Year: 2016, Month: 13, Day: 6}, EtDate{Year: 2016, Month: 1, Day: 1}); err == nil {
+		t.Error("Expected error for invalid date")
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+	tests := []struct {
+		year, ordinal int
+		want          EtDate
+		wantErr       bool
+	}{
+		{2015, 1, EtDate{Year: 2015, Month: 1, Day: 1}, false},
+		{2015, 360, EtDate{Year: 2015, Month: 12, Day: 30}, false},
+		{2015, 366, EtDate{Year: 2015, Month: 13, Day: 6}, false},
+		{2016, 366, EtDate{}, true},
+		{2016, 0, EtDate{}, true},
+	}
+
+	for _, tt := range tests {
+		got, err := FromOrdinal(tt.year, tt.ordinal)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("FromOrdinal(%d, %d) error = %v, wantErr %v", tt.year, tt.ordinal, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr {
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("FromOrdinal(%d, %d) = %+v, want %+v", tt.year, tt.ordinal, got, tt.want)
+		}
+		ordinal, err := got.Ordinal()
+		if err != nil || ordinal != tt.ordinal {
+			t.Errorf("%+v.Ordinal() = %d, %v, want %d", got, ordinal, err, tt.ordinal)
+		}
+	}
+}
+
+func TestEpagomenal(t *testing.T) {
+	tests := []struct {
+		year int
+		want int
+	}{
+		{2011, 6},
+		{2012, 5},
+		{2015, 6},
+		{2016, 5},
+	}
+	for _, tt := range tests {
+		if got := EpagomenalDays(tt.year); got != tt.want {
+			t.Errorf("EpagomenalDays(%d) = %d, want %d", tt.year, got, tt.want)
+		}
+	}
+
+	if !(EtDate{Year: 2015, Month: 13, Day: 6}).IsEpagomenal() {
+		t.Error("Expected Pagume 6 to be epagomenal")
+	}
+	if (EtDate{Year: 2015, Month: 12, Day: 30}).IsEpagomenal() {
+		t.Error("Expected Nehase 30 not to be epagomenal")
+	}
+}
+
+func TestSentinelErrors(t *testing.T) {
+	tests := []struct {
+		err  error
+		want error
+	}{
+		{EtDate{Year: 0, Month: 1, Day: 1}.Validate(), ErrInvalidYear},
+		{EtDate{Year: 2016, Month: 14, Day: 1}.Validate(), ErrInvalidMonth},
+		{EtDate{Year: 2016, Month: 13, Day: 6}.Validate(), ErrInvalidDay},
+	}
+	for _, tt := range tests {
+		if !errors.Is(tt.err, tt.want) {
+			t.Errorf("Got %v, want %v", tt.err, tt.want)
+		}
+	}
+
+	if _, err := JDNToEt(jdOffset - 1); !errors.Is(err, ErrBeforeEpoch) {
+		t.Errorf("JDNToEt before epoch: got %v, want %v", err, ErrBeforeEpoch)
+	}
+	if _, err := FromGregorian(2023, 13, 1); !errors.Is(err, ErrInvalidGregorianMonth) {
+		t.Errorf("FromGregorian with month 13: got %v, want %v", err, ErrInvalidGregorianMonth)
+	}
+}
+
+func TestBetween(t *testing.T) {
+	from := EtDate{Year: 2015, Month: 13, Day: 4}
+	to := EtDate{Year: 2016, Month: 1, Day: 2}
+
+	days, err := Between(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if days != 4 {
+		t.Errorf("Between(%+v, %+v) = %d, want 4", from, to, days)
+	}
+
+	days, err = Between(to, from)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if days != -4 {
+		t.Errorf("Between(%+v, %+v) = %d, want -4", to, from, days)
+	}
+}
+
+func TestSub(t *testing.T) {
+	tests := []struct {
+		d, other EtDate
+		want     int
+	}{
+		{EtDate{Year: 2016, Month: 1, Day: 1}, EtDate{Year: 2016, Month: 1, Day: 1}, 0},
+		{EtDate{Year: 2016, Month: 1, Day: 1}, EtDate{Year: 2015, Month: 13, Day: 5}, 2}, // across Pagume 6
+		{EtDate{Year: 2017, Month: 1, Day: 1}, EtDate{Year: 2016, Month: 13, Day: 5}, 1}, // no Pagume 6 in 2016
+		{EtDate{Year: 2016, Month: 1, Day: 1}, EtDate{Year: 2015, Month: 1, Day: 1}, 366},
+		{EtDate{Year: 2017, Month: 1, Day: 1}, EtDate{Year: 2016, Month: 1, Day: 1}, 365},
+		{EtDate{Year: 2015, Month: 13, Day: 4}, EtDate{Year: 2016, Month: 1, Day: 2}, -4},
+	}
+	for _, tt := range tests {
+		got, err := tt.d.Sub(tt.other)
+		if err != nil {
+			t.Fatalf("%v.Sub(%v) error: %v", tt.d, tt.other, err)
+		}
+		if got != tt.want {
+			t.Errorf("%v.Sub(%v) = %d, want %d", tt.d, tt.other, got, tt.want)
+		}
+		if between, _ := Between(tt.other, tt.d); got != between {
+			t.Errorf("%v.Sub(%v) = %d, but Between(%v, %v) = %d", tt.d, tt.other, got, tt.other, tt.d, between)
+		}
+	}
+
+	if _, err := (EtDate{Year: 2016, Month: 1, Day: 1}).Sub(EtDate{Year: 2016, Month: 13, Day: 6}); !errors.Is(err, ErrInvalidDay) {
+		t.Errorf("Sub with an invalid date error = %v, want ErrInvalidDay", err)
+	}
+}
+
+func TestGregorianFebruaryCenturyRules(t *testing.T) {
+	tests := []struct {
+		year int
+		want int
+	}{
+		{1900, 28},
+		{2000, 29},
+		{2024, 29},
+		{2100, 28},
+	}
+
+	for _, tt := range tests {
+		if got := gregorianDaysInMonth(tt.year, 2); got != tt.want {
+			t.Errorf("gregorianDaysInMonth(%d, 2) = %d, want %d", tt.year, got, tt.want)
+		}
+		_, err := GregorianToJDN(tt.year, 2, 29)
+		if (err == nil) != (tt.want == 29) {
+			t.Errorf("GregorianToJDN(%d, 2, 29) error = %v", tt.year, err)
+		}
+	}
+}
+
+func TestAddDaysUnchecked(t *testing.T) {
+	et := EtDate{Year: 2015, Month: 12, Day: 25}
+	for _, days := range []int{-400, -1, 0, 1, 11, 12, 366, 1461} {
+		want, err := et.AddDays(days)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := et.AddDaysUnchecked(days); got != want {
+			t.Errorf("AddDaysUnchecked(%d) = %+v, want %+v", days, got, want)
+		}
+	}
+}
+
+func BenchmarkAddDaysUnchecked(b *testing.B) {
+	et := EtDate{Year: 2016, Month: 1, Day: 1}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		et.AddDaysUnchecked(400)
+	}
+}
+
+func TestEthiopianLeapYearsInGregorian(t *testing.T) {
+	tests := []struct {
+		gregYear int
+		want     []LeapYear
+	}{
+		// Pagume 6, 2015 fell on 11 September 2023.
+		{2023, []LeapYear{{2015, true}, {2016, false}}},
+		{2024, []LeapYear{{2016, false}, {2017, false}}},
+		{2027, []LeapYear{{2019, true}, {2020, false}}},
+	}
+	for _, tt := range tests {
+		got, err := EthiopianLeapYearsInGregorian(tt.gregYear)
+		if err != nil {
+			t.Fatalf("EthiopianLeapYearsInGregorian(%d) error: %v", tt.gregYear, err)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("EthiopianLeapYearsInGregorian(%d) = %v, want %v", tt.gregYear, got, tt.want)
+		}
+	}
+
+	if _, err := EthiopianLeapYearsInGregorian(0); err == nil {
+		t.Error("Expected error for Gregorian year 0")
+	}
+}
+
+func TestLeapDaysBetween(t *testing.T) {
+	tests := []struct {
+		start, end int
+		want       int
+	}{
+		{2015, 2016, 1},
+		{2016, 2019, 0},
+		{2016, 2020, 1},
+		{2011, 2016, 2},
+		{1, 4, 1},
+		{2016, 2016, 0},
+		{2020, 2010, 0},
+	}
+	for _, tt := range tests {
+		if got := LeapDaysBetween(tt.start, tt.end); got != tt.want {
+			t.Errorf("LeapDaysBetween(%d, %d) = %d, want %d", tt.start, tt.end, got, tt.want)
+		}
+	}
+
+	// The leap days account for every day beyond 365 per year.
+	for _, span := range [][2]int{{1, 2016}, {1999, 2101}, {2015, 2016}} {
+		days := yearStartJDN(span[1]) - yearStartJDN(span[0])
+		if want := 365*(span[1]-span[0]) + LeapDaysBetween(span[0], span[1]); days != want {
+			t.Errorf("Years %d-%d span %d days, want %d", span[0], span[1], days, want)
+		}
+	}
+}
+
+func TestGregorianOfEthiopianMonthDay(t *testing.T) {
+	tests := []struct {
+		etMonth, etDay, gregYear int
+		want                     GregorianDate
+	}{
+		{2, 12, 2025, GregorianDate{Year: 2025, Month: 10, Day: 22}},
+		{1, 17, 2024, GregorianDate{Year: 2024, Month: 9, Day: 27}},
+		{5, 11, 2024, GregorianDate{Year: 2024, Month: 1, Day: 20}},
+		// Tahsas 22 falls on both 1 January and 31 December 2028.
+		{4, 22, 2028, GregorianDate{Year: 2028, Month: 1, Day: 1}},
+		// Pagume 6, 2015 is 11 September 2023.
+		{13, 6, 2023, GregorianDate{Year: 2023, Month: 9, Day: 11}},
+	}
+	for _, tt := range tests {
+		got, err := GregorianOfEthiopianMonthDay(tt.etMonth, tt.etDay, tt.gregYear)
+		if err != nil {
+			t.Errorf("GregorianOfEthiopianMonthDay(%d, %d, %d) returned error: %v", tt.etMonth, tt.etDay, tt.gregYear, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("GregorianOfEthiopianMonthDay(%d, %d, %d) = %+v, want %+v", tt.etMonth, tt.etDay, tt.gregYear, got, tt.want)
+		}
+	}
+
+	// Neither 2016 nor 2017 is a leap year, so Pagume 6 misses 2024.
+	if _, err := GregorianOfEthiopianMonthDay(13, 6, 2024); err == nil {
+		t.Error("Expected error for Pagume 6 in 2024")
+	}
+	if _, err := GregorianOfEthiopianMonthDay(14, 1, 2024); err == nil {
+		t.Error("Expected error for month 14")
+	}
+}
+
+func TestParts(t *testing.T) {
+	tests := []struct {
+		year, month, day int32
+		wantErr          bool
+	}{
+		{2016, 1, 1, false},
+		{2015, 13, 6, false},
+		{math.MaxInt32, 12, 30, false},
+		{2016, 13, 6, true},
+		{0, 1, 1, true},
+		{math.MinInt32, 1, 1, true},
+		{2016, 0, 1, true},
+	}
+	for _, tt := range tests {
+		d, err := FromParts(tt.year, tt.month, tt.day)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("FromParts(%d, %d, %d) error = %v, wantErr %v", tt.year, tt.month, tt.day, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr {
+			continue
+		}
+		y, m, day := d.Parts()
+		if y != tt.year || m != tt.month || day != tt.day {
+			t.Errorf("FromParts(%d, %d, %d).Parts() = %d, %d, %d", tt.year, tt.month, tt.day, y, m, day)
+		}
+	}
+}
+
+func TestMap(t *testing.T) {
+	got := EtDate{Year: 2016, Month: 13, Day: 5}.Map()
+	want := map[string]int{"year": 2016, "month": 13, "day": 5}
+	if len(got) != len(want) {
+		t.Fatalf("Map() = %v, want %v", got, want)
+	}
+	for key, value := range want {
+		if got[key] != value {
+			t.Errorf("Map()[%q] = %d, want %d", key, got[key], value)
+		}
+	}
+}
+
+func TestDaysRemainingInMonth(t *testing.T) {
+	tests := []struct {
+		date EtDate
+		want int
+	}{
+		{EtDate{Year: 2016, Month: 5, Day: 10}, 20},
+		{EtDate{Year: 2016, Month: 5, Day: 30}, 0},
+		{EtDate{Year: 2015, Month: 13, Day: 5}, 1},
+		{EtDate{Year: 2016, Month: 13, Day: 5}, 0},
+	}
+	for _, tt := range tests {
+		got, err := tt.date.DaysRemainingInMonth()
+		if err != nil {
+			t.Errorf("%+v.DaysRemainingInMonth() returned error: %v", tt.date, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%+v.DaysRemainingInMonth() = %d, want %d", tt.date, got, tt.want)
+		}
+	}
+
+	if _, err := (EtDate{Year: 2016, Month: 13, Day: 6}).DaysRemainingInMonth(); err == nil {
+		t.Error("Expected error for Pagume 6 in a common year")
+	}
+}
+
+func TestGregorianMonthsSpanned(t *testing.T) {
+	// 2016 runs from 12 September 2023 to 10 September 2024.
+	months, err := GregorianMonthsSpanned(2016)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(months) != 13 {
+		t.Fatalf("Expected 13 Gregorian months, got %d: %+v", len(months), months)
+	}
+	if months[0] != (GregorianDate{Year: 2023, Month: 9, Day: 12}) {
+		t.Errorf("First month = %+v, want 2023-09-12", months[0])
+	}
+	if months[4] != (GregorianDate{Year: 2024, Month: 1, Day: 1}) {
+		t.Errorf("Fifth month = %+v, want 2024-01-01", months[4])
+	}
+	if months[12] != (GregorianDate{Year: 2024, Month: 9, Day: 1}) {
+		t.Errorf("Last month = %+v, want 2024-09-01", months[12])
+	}
+
+	if _, err := GregorianMonthsSpanned(0); err == nil {
+		t.Error("Expected error for year 0")
+	}
+}
+
+func TestJulianDate(t *testing.T) {
+	et := EtDate{Year: 2016, Month: 1, Day: 1}
+	jdn, err := et.ToJDN()
+	if err != nil {
+		t.Fatal(err)
+	}
+	jd, err := et.JulianDate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 12 September 2023 00:00 UTC is JD 2460199.5.
+	if jdn != 2460200 || jd != 2460199.5 {
+		t.Errorf("ToJDN() = %d, JulianDate() = %v, want 2460200 and 2460199.5", jdn, jd)
+	}
+
+	if _, err := (EtDate{Year: 2016, Month: 13, Day: 6}).JulianDate(); err == nil {
+		t.Error("Expected error for invalid date")
+	}
+}
+
+func TestFormatOrdinalDay(t *testing.T) {
+	tests := []struct {
+		day  int
+		want string
+	}{
+		{1, "1st Meskerem 2016"},
+		{2, "2nd Meskerem 2016"},
+		{3, "3rd Meskerem 2016"},
+		{4, "4th Meskerem 2016"},
+		{11, "11th Meskerem 2016"},
+		{12, "12th Meskerem 2016"},
+		{13, "13th Meskerem 2016"},
+		{21, "21st Meskerem 2016"},
+		{22, "22nd Meskerem 2016"},
+		{30, "30th Meskerem 2016"},
+	}
+	for _, tt := range tests {
+		et := EtDate{Year: 2016, Month: 1, Day: tt.day}
+		if got := et.Format("Do Month YYYY"); got != tt.want {
+			t.Errorf("Format(Do) for day %d = %q, want %q", tt.day, got, tt.want)
+		}
+	}
+}
+
+func TestConsecutiveDays(t *testing.T) {
+	tests := []struct {
+		before, after EtDate
+		want          bool
+	}{
+		// 2015 is a leap year, so Pagume 6 precedes the new year.
+		{EtDate{Year: 2015, Month: 13, Day: 6}, EtDate{Year: 2016, Month: 1, Day: 1}, true},
+		{EtDate{Year: 2015, Month: 13, Day: 5}, EtDate{Year: 2016, Month: 1, Day: 1}, false},
+		// 2016 is a common year, so Pagume 5 is its last day.
+		{EtDate{Year: 2016, Month: 13, Day: 5}, EtDate{Year: 2017, Month: 1, Day: 1}, true},
+		{EtDate{Year: 2016, Month: 12, Day: 30}, EtDate{Year: 2016, Month: 13, Day: 1}, true},
+		{EtDate{Year: 2016, Month: 1, Day: 1}, EtDate{Year: 2016, Month: 1, Day: 1}, false},
+	}
+	for _, tt := range tests {
+		after, err := tt.after.IsDayAfter(tt.before)
+		if err != nil || after != tt.want {
+			t.Errorf("%+v.IsDayAfter(%+v) = %v, %v, want %v", tt.after, tt.before, after, err, tt.want)
+		}
+		before, err := tt.before.IsDayBefore(tt.after)
+		if err != nil || before != tt.want {
+			t.Errorf("%+v.IsDayBefore(%+v) = %v, %v, want %v", tt.before, tt.after, before, err, tt.want)
+		}
+		if reversed, _ := tt.before.IsDayAfter(tt.after); reversed {
+			t.Errorf("%+v.IsDayAfter(%+v) = true, want false", tt.before, tt.after)
+		}
+	}
+
+	if _, err := (EtDate{Year: 2017, Month: 1, Day: 1}).IsDayAfter(EtDate{Year: 2016, Month: 13, Day: 6}); err == nil {
+		t.Error("Expected error for invalid Pagume 6")
+	}
+}
+
+func TestGregorianISO(t *testing.T) {
+	got, err := EtDate{Year: 2016, Month: 1, Day: 1}.GregorianISO()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "2023-09-12" {
+		t.Errorf("GregorianISO() = %q, want %q", got, "2023-09-12")
+	}
+
+	if _, err := (EtDate{Year: 2016, Month: 14, Day: 1}).GregorianISO(); err == nil {
+		t.Error("Expected error for invalid date")
+	}
+}
+
+func TestValidateWith(t *testing.T) {
+	relaxed := ValidateOptions{AllowNonPositiveYear: true}
+	tests := []struct {
+		date      EtDate
+		strictOK  bool
+		relaxedOK bool
+	}{
+		{EtDate{Year: 2016, Month: 1, Day: 1}, true, true},
+		{EtDate{Year: 0, Month: 1, Day: 1}, false, true},
+		{EtDate{Year: -5492, Month: 13, Day: 5}, false, true},
+		{EtDate{Year: 0, Month: 14, Day: 1}, false, false},
+		{EtDate{Year: -1, Month: 13, Day: 6}, false, false},
+	}
+	for _, tt := range tests {
+		if err := tt.date.ValidateWith(ValidateOptions{}); (err == nil) != tt.strictOK {
+			t.Errorf("%+v strict: got error %v, want ok %v", tt.date, err, tt.strictOK)
+		}
+		if err := tt.date.Validate(); (err == nil) != tt.strictOK {
+			t.Errorf("%+v Validate: got error %v, want ok %v", tt.date, err, tt.strictOK)
+		}
+		if err := tt.date.ValidateWith(relaxed); (err == nil) != tt.relaxedOK {
+			t.Errorf("%+v relaxed: got error %v, want ok %v", tt.date, err, tt.relaxedOK)
+		}
+	}
+}
+
+func TestNewYearAcrossGregorianCentury(t *testing.T) {
+	// 2100 CE is not a Gregorian leap year, so from March 2100 Meskerem 1
+	// falls one Gregorian day later than in the 1900s and 2000s.
+	tests := []struct {
+		etYear int
+		want   GregorianDate
+	}{
+		{2092, GregorianDate{Year: 2099, Month: 9, Day: 12}},
+		{2093, GregorianDate{Year: 2100, Month: 9, Day: 12}},
+		{2094, GregorianDate{Year: 2101, Month: 9, Day: 12}},
+		{2095, GregorianDate{Year: 2102, Month: 9, Day: 12}},
+		{2096, GregorianDate{Year: 2103, Month: 9, Day: 13}},
+		{2097, GregorianDate{Year: 2104, Month: 9, Day: 12}},
+		{2098, GregorianDate{Year: 2105, Month: 9, Day: 12}},
+		{2099, GregorianDate{Year: 2106, Month: 9, Day: 12}},
+		{2100, GregorianDate{Year: 2107, Month: 9, Day: 13}},
+		{2101, GregorianDate{Year: 2108, Month: 9, Day: 12}},
+		{2102, GregorianDate{Year: 2109, Month: 9, Day: 12}},
+		{2103, GregorianDate{Year: 2110, Month: 9, Day: 12}},
+		{2104, GregorianDate{Year: 2111, Month: 9, Day: 13}},
+		{2105, GregorianDate{Year: 2112, Month: 9, Day: 12}},
+		{2106, GregorianDate{Year: 2113, Month: 9, Day: 12}},
+		{2107, GregorianDate{Year: 2114, Month: 9, Day: 12}},
+		{2108, GregorianDate{Year: 2115, Month: 9, Day: 13}},
+	}
+	for _, tt := range tests {
+		got, err := GregorianDateOfEthiopianNewYear(tt.etYear)
+		if err != nil {
+			t.Errorf("GregorianDateOfEthiopianNewYear(%d) returned error: %v", tt.etYear, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("GregorianDateOfEthiopianNewYear(%d) = %+v, want %+v", tt.etYear, got, tt.want)
+		}
+	}
+}
+
+// julianCalendarToJDN converts a date in the Julian calendar to a Julian Day
+// Number, independently of the conversions under test.
+func julianCalendarToJDN(year, month, day int) int {
+	a := (14 - month) / 12
+	y := year + 4800 - a
+	m := month + 12*a - 3
+	return day + (153*m+2)/5 + 365*y + y/4 - 32083
+}
+
+func TestNewYearMatchesJulianCalendar(t *testing.T) {
+	// The Ethiopian leap cycle is the Julian one: Meskerem 1 is 29 August in
+	// the Julian calendar, or 30 August after an Ethiopian leap year.
+	for year := 1; year <= 10000; year++ {
+		day := 29
+		if IsLeap(year - 1) {
+			day = 30
+		}
+		want := julianCalendarToJDN(year+7, 8, day)
+		got, err := (EtDate{Year: year, Month: 1, Day: 1}).ToJDN()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Fatalf("Meskerem 1, %d: JDN %d, want %d", year, got, want)
+		}
+	}
+}
+
+func TestDaysThroughEndOfMonth(t *testing.T) {
+	tests := []struct {
+		date EtDate
+		want int
+	}{
+		{EtDate{Year: 2016, Month: 1, Day: 1}, 30},
+		{EtDate{Year: 2016, Month: 5, Day: 11}, 20},
+		{EtDate{Year: 2016, Month: 12, Day: 30}, 1},
+		{EtDate{Year: 2016, Month: 13, Day: 5}, 1}, // last day of a common year
+		{EtDate{Year: 2015, Month: 13, Day: 5}, 2}, // Pagume 6 still to come
+		{EtDate{Year: 2015, Month: 13, Day: 1}, 6},
+	}
+	for _, tt := range tests {
+		got, err := tt.date.DaysThroughEndOfMonth()
+		if err != nil {
+			t.Fatalf("DaysThroughEndOfMonth(%v) error: %v", tt.date, err)
+		}
+		if got != tt.want {
+			t.Errorf("DaysThroughEndOfMonth(%v) = %d, want %d", tt.date, got, tt.want)
+		}
+	}
+
+	if _, err := (EtDate{Year: 2016, Month: 13, Day: 6}).DaysThroughEndOfMonth(); !errors.Is(err, ErrInvalidDay) {
+		t.Errorf("DaysThroughEndOfMonth(2016-13-06) error = %v, want ErrInvalidDay", err)
+	}
+}
+
+func TestDaysSinceNewYear(t *testing.T) {
+	tests := []struct {
+		date EtDate
+		want int
+	}{
+		{EtDate{Year: 2016, Month: 1, Day: 1}, 0},
+		{EtDate{Year: 2016, Month: 2, Day: 1}, 30},
+		{EtDate{Year: 2016, Month: 13, Day: 5}, 364}, // last day of a common year
+		{EtDate{Year: 2015, Month: 13, Day: 6}, 365}, // last day of a leap year
+	}
+	for _, tt := range tests {
+		got, err := tt.date.DaysSinceNewYear()
+		if err != nil {
+			t.Fatalf("DaysSinceNewYear(%v) error: %v", tt.date, err)
+		}
+		if got != tt.want {
+			t.Errorf("DaysSinceNewYear(%v) = %d, want %d", tt.date, got, tt.want)
+		}
+	}
+
+	if _, err := (EtDate{Year: 2016, Month: 13, Day: 6}).DaysSinceNewYear(); !errors.Is(err, ErrInvalidDay) {
+		t.Errorf("DaysSinceNewYear(2016-13-06) error = %v, want ErrInvalidDay", err)
+	}
+}
+
+func TestDaysUntilNewYear(t *testing.T) {
+	tests := []struct {
+		date EtDate
+		want int
+	}{
+		{EtDate{Year: 2016, Month: 1, Day: 1}, 0},
+		{EtDate{Year: 2016, Month: 1, Day: 2}, 364},
+		{EtDate{Year: 2016, Month: 7, Day: 1}, 185},
+		{EtDate{Year: 2016, Month: 13, Day: 5}, 1}, // last day of a common year
+		{EtDate{Year: 2015, Month: 13, Day: 5}, 2}, // Pagume 6 still to come
+		{EtDate{Year: 2015, Month: 13, Day: 6}, 1}, // last day of a leap year
+		{EtDate{Year: 2015, Month: 13, Day: 1}, 6},
+	}
+	for _, tt := range tests {
+		got, err := tt.date.DaysUntilNewYear()
+		if err != nil {
+			t.Fatalf("DaysUntilNewYear(%v) error: %v", tt.date, err)
+		}
+		if got != tt.want {
+			t.Errorf("DaysUntilNewYear(%v) = %d, want %d", tt.date, got, tt.want)
+		}
+	}
+
+	if _, err := (EtDate{Year: 2016, Month: 13, Day: 6}).DaysUntilNewYear(); err == nil {
+		t.Error("Expected error for Pagume 6 in a common year")
+	}
+}
+
+func TestMonthsAgo(t *testing.T) {
+	tests := []struct {
+		date EtDate
+		n    int
+		want EtDate
+	}{
+		{EtDate{Year: 2016, Month: 7, Day: 15}, 1, EtDate{Year: 2016, Month: 6, Day: 15}},
+		{EtDate{Year: 2016, Month: 1, Day: 15}, 1, EtDate{Year: 2015, Month: 13, Day: 6}},
+		{EtDate{Year: 2016, Month: 7, Day: 15}, 13, EtDate{Year: 2015, Month: 7, Day: 15}},
+		{EtDate{Year: 2016, Month: 7, Day: 15}, 0, EtDate{Year: 2016, Month: 7, Day: 15}},
+		{EtDate{Year: 2015, Month: 13, Day: 6}, 13, EtDate{Year: 2014, Month: 13, Day: 5}}, // leap Pagume 6 onto a common Pagume
+		{EtDate{Year: 2017, Month: 12, Day: 30}, 12, EtDate{Year: 2016, Month: 13, Day: 5}},
+		{EtDate{Year: 2016, Month: 12, Day: 30}, 12, EtDate{Year: 2015, Month: 13, Day: 6}},
+	}
+	for _, tt := range tests {
+		got := tt.date.MonthsAgo(tt.n)
+		if got != tt.want {
+			t.Errorf("MonthsAgo(%v, %d) = %v, want %v", tt.date, tt.n, got, tt.want)
+		}
+		if err := got.Validate(); err != nil {
+			t.Errorf("MonthsAgo(%v, %d) = %v is invalid: %v", tt.date, tt.n, got, err)
+		}
+	}
+}
+
+func TestNormalizePartial(t *testing.T) {
+	tests := []struct {
+		year, month, day int
+		want             EtDate
+	}{
+		{2016, 0, 0, EtDate{Year: 2016, Month: 1, Day: 1}},
+		{2016, 7, 0, EtDate{Year: 2016, Month: 7, Day: 1}},
+		{2016, 7, 15, EtDate{Year: 2016, Month: 7, Day: 15}},
+		{2015, 13, 6, EtDate{Year: 2015, Month: 13, Day: 6}},
+	}
+	for _, tt := range tests {
+		got, err := NormalizePartial(tt.year, tt.month, tt.day)
+		if err != nil {
+			t.Errorf("NormalizePartial(%d, %d, %d) returned error: %v", tt.year, tt.month, tt.day, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("NormalizePartial(%d, %d, %d) = %v, want %v", tt.year, tt.month, tt.day, got, tt.want)
+		}
+	}
+
+	invalid := []struct {
+		year, month, day int
+		want             error
+	}{
+		{0, 0, 0, ErrInvalidYear},
+		{2016, 0, 15, ErrInvalidMonth},
+		{2016, 14, 0, ErrInvalidMonth},
+		{2016, -1, 0, ErrInvalidMonth},
+		{2016, 13, 6, ErrInvalidDay},
+		{2016, 1, -1, ErrInvalidDay},
+	}
+	for _, tt := range invalid {
+		if _, err := NormalizePartial(tt.year, tt.month, tt.day); !errors.Is(err, tt.want) {
+			t.Errorf("NormalizePartial(%d, %d, %d) error = %v, want %v", tt.year, tt.month, tt.day, err, tt.want)
+		}
+	}
+}
+
+func TestYearFraction(t *testing.T) {
+	tests := []struct {
+		date EtDate
+		want float64
+	}{
+		{EtDate{Year: 2016, Month: 1, Day: 1}, 1.0 / 365},
+		{EtDate{Year: 2015, Month: 1, Day: 1}, 1.0 / 366},
+		{EtDate{Year: 2016, Month: 7, Day: 1}, 181.0 / 365},
+		{EtDate{Year: 2016, Month: 13, Day: 5}, 1},
+		{EtDate{Year: 2015, Month: 13, Day: 5}, 365.0 / 366},
+		{EtDate{Year: 2015, Month: 13, Day: 6}, 1},
+	}
+	for _, tt := range tests {
+		got, err := tt.date.YearFraction()
+		if err != nil {
+			t.Fatalf("YearFraction(%v) error: %v", tt.date, err)
+		}
+		if math.Abs(got-tt.want) > 1e-12 {
+			t.Errorf("YearFraction(%v) = %v, want %v", tt.date, got, tt.want)
+		}
+	}
+
+	if _, err := (EtDate{Year: 2016, Month: 13, Day: 6}).YearFraction(); err == nil {
+		t.Error("Expected error for Pagume 6 in a common year")
+	}
+}
+
+func TestFormatLiterals(t *testing.T) {
+	et := EtDate{Year: 2016, Month: 1, Day: 1}
+	tests := []struct {
+		layout, want string
+	}{
+		{"[Meeting] Month YYYY", "Meeting Meskerem 2016"},
+		{"[Do not disturb] Do Month", "Do not disturb 1st Meskerem"},
+		{"[YYYY]YYYY[MM]MM", "YYYY2016MM01"},
+		{"DD[.]MM[.]YYYY", "01.01.2016"},
+		{"[Month]:Month", "Month:Meskerem"},
+		{"[]DD", "01"},
+		{"[DD", "[01"},
+		{"DD] MM", "01] 01"},
+	}
+	for _, tt := range tests {
+		if got := et.Format(tt.layout); got != tt.want {
+			t.Errorf("Format(%q) = %q, want %q", tt.layout, got, tt.want)
+		}
+	}
+
+	if got := et.FormatLocale("[Era:] Era", LocaleAmharic); got != "Era: ዓ.ም." {
+		t.Errorf("FormatLocale with literal = %q", got)
+	}
+}
+
+func TestFormatTokenScanner(t *testing.T) {
+	tests := []struct {
+		date         EtDate
+		layout, want string
+	}{
+		{EtDate{Year: 2016, Month: 1, Day: 5}, "Mon M Month", "Mes 1 Meskerem"},
+		{EtDate{Year: 2016, Month: 1, Day: 5}, "D/M/YYYY", "5/1/2016"},
+		{EtDate{Year: 2016, Month: 12, Day: 30}, "DD-MM-YYYY", "30-12-2016"},
+		// Substituted names are not scanned again, unlike with ReplaceAll.
+		{EtDate{Year: 2016, Month: 8, Day: 3}, "Month M", "Miazia 8"},
+		{EtDate{Year: 2016, Month: 7, Day: 3}, "Month (Mon)", "Megabit (Meg)"},
+		{EtDate{Year: 2016, Month: 13, Day: 2}, "Do Month", "2nd Pagume"},
+		{EtDate{Year: 1111, Month: 11, Day: 11}, "YYYYMMDD", "11111111"},
+		{EtDate{Year: 2016, Month: 5, Day: 11}, "MonthMonMMM", "TirTir055"},
+		{EtDate{Year: 2016, Month: 14, Day: 1}, "Month", "%!Month(14)"},
+		// Ge'ez year with Arabic day and month.
+		{EtDate{Year: 2016, Month: 5, Day: 11}, "DD Month GeezYYYY", "11 Tir ፳፻፲፮"},
+		{EtDate{Year: 2016, Month: 5, Day: 11}, "D/M/GeezYYYY (YYYY)", "11/5/፳፻፲፮ (2016)"},
+		{EtDate{Year: 0, Month: 1, Day: 1}, "GeezYYYY", "0000"},
+	}
+	for _, tt := range tests {
+		if got := tt.date.Format(tt.layout); got != tt.want {
+			t.Errorf("Format(%v, %q) = %q, want %q", tt.date, tt.layout, got, tt.want)
+		}
+	}
+
+	if got := (EtDate{Year: 2016, Month: 1, Day: 1}).FormatLocale("Mon Month", LocaleAmharic); got != "መስከረም መስከረም" {
+		t.Errorf("FormatLocale(Mon Month, am) = %q", got)
+	}
+}
+
+func TestCoerce(t *testing.T) {
+	tests := []struct {
+		date, want EtDate
+	}{
+		{EtDate{Year: 2016, Month: 1, Day: 1}, EtDate{Year: 2016, Month: 1, Day: 1}},
+		{EtDate{Year: 2016, Month: 13, Day: 6}, EtDate{Year: 2016, Month: 13, Day: 5}},
+		{EtDate{Year: 2015, Month: 13, Day: 6}, EtDate{Year: 2015, Month: 13, Day: 6}},
+		{EtDate{Year: 2016, Month: 14, Day: 1}, EtDate{Year: 2016, Month: 13, Day: 1}},
+		{EtDate{Year: 2016, Month: 14, Day: 30}, EtDate{Year: 2016, Month: 13, Day: 5}},
+		{EtDate{Year: 2016, Month: 0, Day: 0}, EtDate{Year: 2016, Month: 1, Day: 1}},
+		{EtDate{Year: 2016, Month: 5, Day: 31}, EtDate{Year: 2016, Month: 5, Day: 30}},
+		{EtDate{Year: 0, Month: 1, Day: 1}, EtDate{Year: 1, Month: 1, Day: 1}},
+		{EtDate{Year: -5, Month: 13, Day: 7}, EtDate{Year: 1, Month: 13, Day: 5}},
+	}
+	for _, tt := range tests {
+		got := tt.date.Coerce()
+		if got != tt.want {
+			t.Errorf("Coerce(%v) = %v, want %v", tt.date, got, tt.want)
+		}
+		if err := got.Validate(); err != nil {
+			t.Errorf("Coerce(%v) = %v is invalid: %v", tt.date, got, err)
+		}
+	}
+}
+
+func TestEpochDay(t *testing.T) {
+	tests := []struct {
+		date EtDate
+		want int
+	}{
+		{EtDate{Year: 1, Month: 1, Day: 1}, 0},
+		{EtDate{Year: 1, Month: 1, Day: 2}, 1},
+		{EtDate{Year: 1, Month: 13, Day: 5}, 364},
+		{EtDate{Year: 2, Month: 1, Day: 1}, 365},
+		{EtDate{Year: 4, Month: 1, Day: 1}, 1096}, // after leap year 3
+		{EtDate{Year: 2016, Month: 1, Day: 1}, 2460200 - 1724221},
+	}
+	for _, tt := range tests {
+		got, err := tt.date.EpochDay()
+		if err != nil {
+			t.Fatalf("EpochDay(%v) error: %v", tt.date, err)
+		}
+		if got != tt.want {
+			t.Errorf("EpochDay(%v) = %d, want %d", tt.date, got, tt.want)
+		}
+		back, err := FromEpochDay(got)
+		if err != nil || back != tt.date {
+			t.Errorf("FromEpochDay(%d) = %v, %v, want %v", got, back, err, tt.date)
+		}
+	}
+
+	for n := 0; n < 3000; n += 7 {
+		d, err := FromEpochDay(n)
+		if err != nil {
+			t.Fatalf("FromEpochDay(%d) error: %v", n, err)
+		}
+		if got, _ := d.EpochDay(); got != n {
+			t.Fatalf("EpochDay(FromEpochDay(%d)) = %d", n, got)
+		}
+	}
+
+	if _, err := FromEpochDay(-1); !errors.Is(err, ErrBeforeEpoch) {
+		t.Errorf("FromEpochDay(-1) error = %v, want ErrBeforeEpoch", err)
+	}
+	if _, err := (EtDate{Year: 2016, Month: 13, Day: 6}).EpochDay(); err == nil {
+		t.Error("Expected error for an invalid date")
+	}
+}
+
+func TestAgeInMonths(t *testing.T) {
+	tests := []struct {
+		birth, asOf EtDate
+		want        int
+	}{
+		{EtDate{Year: 2016, Month: 1, Day: 10}, EtDate{Year: 2016, Month: 1, Day: 10}, 0},
+		{EtDate{Year: 2016, Month: 1, Day: 10}, EtDate{Year: 2016, Month: 2, Day: 9}, 0},
+		{EtDate{Year: 2016, Month: 1, Day: 10}, EtDate{Year: 2016, Month: 2, Day: 10}, 1},
+		{EtDate{Year: 2015, Month: 1, Day: 10}, EtDate{Year: 2016, Month: 2, Day: 10}, 14},
+		// The 14th month is not complete until Tikimt 10.
+		{EtDate{Year: 2015, Month: 1, Day: 10}, EtDate{Year: 2016, Month: 2, Day: 9}, 13},
+		{EtDate{Year: 2015, Month: 12, Day: 15}, EtDate{Year: 2016, Month: 1, Day: 14}, 1},
+		{EtDate{Year: 2015, Month: 12, Day: 15}, EtDate{Year: 2016, Month: 1, Day: 15}, 2},
+		// Born on Megabit 30: Pagume's last day completes a month.
+		{EtDate{Year: 2016, Month: 12, Day: 30}, EtDate{Year: 2016, Month: 13, Day: 4}, 0},
+		{EtDate{Year: 2016, Month: 12, Day: 30}, EtDate{Year: 2016, Month: 13, Day: 5}, 1},
+		{EtDate{Year: 2016, Month: 12, Day: 30}, EtDate{Year: 2017, Month: 1, Day: 29}, 1},
+		{EtDate{Year: 2016, Month: 12, Day: 30}, EtDate{Year: 2017, Month: 1, Day: 30}, 2},
+	}
+	for _, tt := range tests {
+		got, err := tt.birth.AgeInMonths(tt.asOf)
+		if err != nil {
+			t.Fatalf("AgeInMonths(%v, %v) error: %v", tt.birth, tt.asOf, err)
+		}
+		if got != tt.want {
+			t.Errorf("AgeInMonths(%v, %v) = %d, want %d", tt.birth, tt.asOf, got, tt.want)
+		}
+	}
+
+	if _, err := (EtDate{Year: 2016, Month: 1, Day: 10}).AgeInMonths(EtDate{Year: 2016, Month: 1, Day: 9}); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("AgeInMonths before birth error = %v, want ErrInvalidRange", err)
+	}
+	if _, err := (EtDate{Year: 2016, Month: 13, Day: 6}).AgeInMonths(EtDate{Year: 2017, Month: 1, Day: 1}); !errors.Is(err, ErrInvalidDay) {
+		t.Errorf("AgeInMonths with invalid birth error = %v, want ErrInvalidDay", err)
+	}
+}
+
+func TestValidationError(t *testing.T) {
+	tests := []struct {
+		date  EtDate
+		field string
+		value int
+		want  error
+	}{
+		{EtDate{Year: 0, Month: 1, Day: 1}, "year", 0, ErrInvalidYear},
+		{EtDate{Year: -3, Month: 1, Day: 1}, "year", -3, ErrInvalidYear},
+		{EtDate{Year: 2016, Month: 0, Day: 1}, "month", 0, ErrInvalidMonth},
+		{EtDate{Year: 2016, Month: 14, Day: 1}, "month", 14, ErrInvalidMonth},
+		{EtDate{Year: 2016, Month: 1, Day: 0}, "day", 0, ErrInvalidDay},
+		{EtDate{Year: 2016, Month: 1, Day: 31}, "day", 31, ErrInvalidDay},
+		{EtDate{Year: 2016, Month: 13, Day: 6}, "day", 6, ErrInvalidDay},
+	}
+	for _, tt := range tests {
+		err := tt.date.Validate()
+		var verr *ValidationError
+		if !errors.As(err, &verr) {
+			t.Errorf("Validate(%v) = %v, want a *ValidationError", tt.date, err)
+			continue
+		}
+		if verr.Field != tt.field || verr.Value != tt.value {
+			t.Errorf("Validate(%v) field = %q, value = %d, want %q, %d", tt.date, verr.Field, verr.Value, tt.field, tt.value)
+		}
+		if !errors.Is(err, tt.want) {
+			t.Errorf("Validate(%v) does not wrap %v", tt.date, tt.want)
+		}
+		if verr.Msg != tt.want.Error() || err.Error() != tt.want.Error() {
+			t.Errorf("Validate(%v) message = %q, want %q", tt.date, err.Error(), tt.want.Error())
+		}
+	}
+
+	// Errors from functions that validate internally still carry the field.
+	var verr *ValidationError
+	if _, err := (EtDate{Year: 2016, Month: 2, Day: 31}).ToJDN(); !errors.As(err, &verr) || verr.Field != "day" {
+		t.Errorf("ToJDN error = %v, want a day ValidationError", err)
+	}
+}
+
+func TestMonthLengths(t *testing.T) {
+	for _, year := range []int{2015, 2016, 3, 4} {
+		lengths := MonthLengths(year)
+		if lengths[0] != 0 {
+			t.Errorf("MonthLengths(%d)[0] = %d, want 0", year, lengths[0])
+		}
+		for month := 1; month <= 13; month++ {
+			if lengths[month] != DaysInMonth(year, month) {
+				t.Errorf("MonthLengths(%d)[%d] = %d, want %d", year, month, lengths[month], DaysInMonth(year, month))
+			}
+		}
+	}
+
+	// Modifying the result must not affect later calls.
+	lengths := MonthLengths(2016)
+	lengths[1] = 0
+	if MonthLengths(2016)[1] != 30 {
+		t.Error("MonthLengths returned shared state")
+	}
+}
+
+func TestMonthSpan(t *testing.T) {
+	tests := []struct {
+		a, b EtDate
+		want int
+	}{
+		{EtDate{Year: 2016, Month: 1, Day: 1}, EtDate{Year: 2016, Month: 1, Day: 30}, 0},
+		{EtDate{Year: 2016, Month: 1, Day: 30}, EtDate{Year: 2016, Month: 2, Day: 1}, 1},
+		{EtDate{Year: 2016, Month: 12, Day: 30}, EtDate{Year: 2016, Month: 13, Day: 1}, 1},
+		{EtDate{Year: 2015, Month: 13, Day: 6}, EtDate{Year: 2016, Month: 1, Day: 1}, 1},
+		{EtDate{Year: 2015, Month: 1, Day: 1}, EtDate{Year: 2016, Month: 1, Day: 1}, 13},
+		{EtDate{Year: 2016, Month: 13, Day: 5}, EtDate{Year: 2015, Month: 13, Day: 6}, -13},
+		{EtDate{Year: 2016, Month: 1, Day: 1}, EtDate{Year: 2015, Month: 12, Day: 1}, -2},
+	}
+	for _, tt := range tests {
+		if got := MonthSpan(tt.a, tt.b); got != tt.want {
+			t.Errorf("MonthSpan(%v, %v) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+
+	// AgeInMonths counts days; MonthSpan does not.
+	if months, _ := (EtDate{Year: 2016, Month: 1, Day: 30}).AgeInMonths(EtDate{Year: 2016, Month: 2, Day: 1}); months != 0 {
+		t.Errorf("AgeInMonths(2016-01-30, 2016-02-01) = %d, want 0", months)
+	}
+}
+
+func TestFormatDefault(t *testing.T) {
+	if got := (EtDate{Year: 2016, Month: 1, Day: 1}).FormatDefault(); got != "01 Meskerem 2016" {
+		t.Errorf("FormatDefault() = %q, want %q", got, "01 Meskerem 2016")
+	}
+	if got := (EtDate{Year: 2015, Month: 13, Day: 6}).FormatDefault(); got != "06 Pagume 2015" {
+		t.Errorf("FormatDefault() = %q, want %q", got, "06 Pagume 2015")
+	}
+}
+
+func TestAddDaysBeforeEpoch(t *testing.T) {
+	d := EtDate{Year: 2, Month: 1, Day: 1}
+	if got, err := d.AddDays(-365); err != nil || got != (EtDate{Year: 1, Month: 1, Day: 1}) {
+		t.Errorf("AddDays(-365) = %v, %v, want 0001-01-01", got, err)
+	}
+
+	_, err := d.AddDays(-366)
+	if !errors.Is(err, ErrBeforeEpoch) {
+		t.Fatalf("AddDays(-366) error = %v, want ErrBeforeEpoch", err)
+	}
+	want := "adding -366 days to 0002-01-01 would precede the calendar epoch, Meskerem 1 of year 1: jdn before Ethiopian epoch"
+	if err.Error() != want {
+		t.Errorf("AddDays(-366) error = %q, want %q", err, want)
+	}
+
+	if _, err := (EtDate{Year: 2016, Month: 1, Day: 1}).AddDays(-1_000_000); !errors.Is(err, ErrBeforeEpoch) {
+		t.Errorf("AddDays(-1000000) error = %v, want ErrBeforeEpoch", err)
+	}
+}
+
+func TestValidateFields(t *testing.T) {
+	tests := []struct {
+		year, month, day int
+		want             error
+	}{
+		{2016, 1, 1, nil},
+		{2015, 13, 6, nil},
+		{2016, 13, 5, nil},
+		{0, 1, 1, ErrInvalidYear},
+		{2016, 0, 1, ErrInvalidMonth},
+		{2016, 14, 1, ErrInvalidMonth},
+		{2016, 1, 0, ErrInvalidDay},
+		{2016, 1, 31, ErrInvalidDay},
+		{2016, 13, 6, ErrInvalidDay},
+	}
+	for _, tt := range tests {
+		err := ValidateFields(tt.year, tt.month, tt.day)
+		if tt.want == nil && err != nil || tt.want != nil && !errors.Is(err, tt.want) {
+			t.Errorf("ValidateFields(%d, %d, %d) = %v, want %v", tt.year, tt.month, tt.day, err, tt.want)
+		}
+		// The struct-based check agrees.
+		structErr := EtDate{Year: tt.year, Month: tt.month, Day: tt.day}.Validate()
+		if (err == nil) != (structErr == nil) || err != nil && err.Error() != structErr.Error() {
+			t.Errorf("ValidateFields(%d, %d, %d) = %v, but Validate() = %v", tt.year, tt.month, tt.day, err, structErr)
+		}
+	}
+}
+
+func TestLastOccurrenceOf(t *testing.T) {
+	tests := []struct {
+		date       EtDate
+		month, day int
+		want       EtDate
+	}{
+		{EtDate{Year: 2016, Month: 5, Day: 11}, 5, 11, EtDate{Year: 2016, Month: 5, Day: 11}}, // on the day itself
+		{EtDate{Year: 2016, Month: 5, Day: 11}, 1, 17, EtDate{Year: 2016, Month: 1, Day: 17}}, // earlier this year
+		{EtDate{Year: 2016, Month: 1, Day: 5}, 3, 1, EtDate{Year: 2015, Month: 3, Day: 1}},    // later in the year: last year's
+		{EtDate{Year: 2016, Month: 5, Day: 1}, 13, 6, EtDate{Year: 2015, Month: 13, Day: 6}},
+		{EtDate{Year: 2019, Month: 13, Day: 5}, 13, 6, EtDate{Year: 2015, Month: 13, Day: 6}}, // skips 2016-2018
+		{EtDate{Year: 2019, Month: 13, Day: 6}, 13, 6, EtDate{Year: 2019, Month: 13, Day: 6}},
+		{EtDate{Year: 2020, Month: 1, Day: 1}, 13, 6, EtDate{Year: 2019, Month: 13, Day: 6}},
+	}
+	for _, tt := range tests {
+		got, err := tt.date.LastOccurrenceOf(tt.month, tt.day)
+		if err != nil {
+			t.Fatalf("LastOccurrenceOf(%v, %d, %d) error: %v", tt.date, tt.month, tt.day, err)
+		}
+		if got != tt.want {
+			t.Errorf("LastOccurrenceOf(%v, %d, %d) = %v, want %v", tt.date, tt.month, tt.day, got, tt.want)
+		}
+	}
+
+	if _, err := (EtDate{Year: 2016, Month: 1, Day: 1}).LastOccurrenceOf(13, 7); !errors.Is(err, ErrInvalidDay) {
+		t.Errorf("LastOccurrenceOf(13, 7) error = %v, want ErrInvalidDay", err)
+	}
+	if _, err := (EtDate{Year: 2016, Month: 1, Day: 1}).LastOccurrenceOf(14, 1); !errors.Is(err, ErrInvalidMonth) {
+		t.Errorf("LastOccurrenceOf(14, 1) error = %v, want ErrInvalidMonth", err)
+	}
+	if _, err := (EtDate{Year: 2, Month: 1, Day: 1}).LastOccurrenceOf(13, 6); !errors.Is(err, ErrBeforeEpoch) {
+		t.Errorf("LastOccurrenceOf(13, 6) from year 2 error = %v, want ErrBeforeEpoch", err)
+	}
+}
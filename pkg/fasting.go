@@ -0,0 +1,47 @@
+package ethiopiancalendar
+
+import "time"
+
+// IsFastingDay reports whether d is a fasting day of the Ethiopian Orthodox
+// Tewahedo Church and, if so, the name of the fast. It covers the
+// fixed-date fasts:
+//
+//   - Tsome Nebiyat (the fast of the prophets), from Hidar 15 to the eve of
+//     Genna;
+//   - Tsome Filseta (the fast of the Assumption), Nehase 1 to 15;
+//   - Gahad on Tir 10, the eve of Timket;
+//   - the weekly fasts Tsome Rob and Tsome Arb on every Wednesday and Friday,
+//     except when Genna or Timket falls on them.
+//
+// The movable fasts and exemptions depend on the date of Fasika (Easter) and
+// are not covered: Hudade (Lent), Tsome Nenewe, Tsome Hawaryat and the
+// fifty days after Fasika during which the weekly fasts are not kept.
+func (d EtDate) IsFastingDay() (bool, string, error) {
+	weekday, err := d.Weekday()
+	if err != nil {
+		return false, "", err
+	}
+
+	genna := 29
+	if IsLeap(d.Year - 1) {
+		genna = 28
+	}
+	switch {
+	case d.Month == 4 && d.Day == genna, d.Month == 5 && d.Day == 11:
+		return false, "", nil
+	case d.Month == 3 && d.Day >= 15, d.Month == 4 && d.Day < genna:
+		return true, "Tsome Nebiyat", nil
+	case d.Month == 12 && d.Day <= 15:
+		return true, "Tsome Filseta", nil
+	case d.Month == 5 && d.Day == 10:
+		return true, "Gahad", nil
+	}
+
+	switch time.Weekday(weekday) {
+	case time.Wednesday:
+		return true, "Tsome Rob", nil
+	case time.Friday:
+		return true, "Tsome Arb", nil
+	}
+	return false, "", nil
+}
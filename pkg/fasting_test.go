@@ -0,0 +1,40 @@
+package ethiopiancalendar
+
+import "testing"
+
+func TestIsFastingDay(t *testing.T) {
+	tests := []struct {
+		date EtDate
+		want bool
+		name string
+	}{
+		{EtDate{Year: 2016, Month: 1, Day: 2}, true, "Tsome Rob"}, // Wednesday, 13 September 2023
+		{EtDate{Year: 2016, Month: 1, Day: 4}, true, "Tsome Arb"}, // Friday, 15 September 2023
+		{EtDate{Year: 2016, Month: 1, Day: 3}, false, ""},         // Thursday
+		{EtDate{Year: 2016, Month: 3, Day: 13}, false, ""},        // Thursday before the fast
+		{EtDate{Year: 2016, Month: 3, Day: 15}, true, "Tsome Nebiyat"},
+		{EtDate{Year: 2016, Month: 4, Day: 27}, true, "Tsome Nebiyat"},
+		{EtDate{Year: 2016, Month: 4, Day: 28}, false, ""}, // Genna after a leap year
+		{EtDate{Year: 2017, Month: 4, Day: 28}, true, "Tsome Nebiyat"},
+		{EtDate{Year: 2017, Month: 4, Day: 29}, false, ""},
+		{EtDate{Year: 2016, Month: 5, Day: 10}, true, "Gahad"},
+		{EtDate{Year: 2016, Month: 12, Day: 1}, true, "Tsome Filseta"},
+		{EtDate{Year: 2016, Month: 12, Day: 15}, true, "Tsome Filseta"},
+		{EtDate{Year: 2016, Month: 12, Day: 16}, false, ""}, // Thursday, the feast of Filseta
+		{EtDate{Year: 2014, Month: 4, Day: 29}, false, ""},  // Genna on Friday, 7 January 2022
+		{EtDate{Year: 2014, Month: 5, Day: 11}, false, ""},  // Timket on Wednesday, 19 January 2022
+	}
+	for _, tt := range tests {
+		got, name, err := tt.date.IsFastingDay()
+		if err != nil {
+			t.Fatalf("IsFastingDay(%v) error: %v", tt.date, err)
+		}
+		if got != tt.want || name != tt.name {
+			t.Errorf("IsFastingDay(%v) = %v, %q, want %v, %q", tt.date, got, name, tt.want, tt.name)
+		}
+	}
+
+	if _, _, err := (EtDate{Year: 2016, Month: 13, Day: 6}).IsFastingDay(); err == nil {
+		t.Error("Expected error for an invalid date")
+	}
+}
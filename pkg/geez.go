@@ -0,0 +1,118 @@
+package ethiopiancalendar
+
+import (
+	"errors"
+	"strings"
+)
+
+// Ge'ez numerals: ፩ (1) to ፱ (9) and ፲ (10) to ፺ (90) are consecutive code
+// points, followed by ፻ (100) and ፼ (10000). There is no zero.
+const (
+	geezOne         = '፩'
+	geezTen         = '፲'
+	geezHundred     = '፻'
+	geezTenThousand = '፼'
+)
+
+// geezPrefixLen returns the length in bytes of the run of Ge'ez numerals
+// at the start of s.
+func geezPrefixLen(s string) int {
+	for i, r := range s {
+		if r < geezOne || r > geezTenThousand {
+			return i
+		}
+	}
+	return len(s)
+}
+
+// ErrInvalidGeez is returned by FromGeez for malformed input and by ToGeez
+// for numbers below 1.
+var ErrInvalidGeez = errors.New("invalid Ge'ez numeral")
+
+// FromGeez converts a number written in Ge'ez numerals, such as ፳፻፲፮
+// (2016), to an int. Within each group a tens digit may precede a ones
+// digit; ፻ multiplies the group before it by 100 and ፼ multiplies
+// everything before it by 10000, with an implicit one when nothing precedes
+// them (፻ is 100). Only the canonical spelling that ToGeez produces is
+// accepted, so ፻፻ is rejected rather than read as 200 (፪፻) and ፩፻ rather
+// than read as 100 (፻).
+func FromGeez(s string) (int, error) {
+	total, section, part := 0, 0, 0
+	hasTens, hasOnes, empty := false, false, true
+	for _, r := range s {
+		empty = false
+		switch {
+		case r >= geezOne && r < geezOne+9:
+			if hasOnes {
+				return 0, ErrInvalidGeez
+			}
+			part += int(r-geezOne) + 1
+			hasOnes = true
+		case r >= geezTen && r < geezTen+9:
+			if hasTens || hasOnes {
+				return 0, ErrInvalidGeez
+			}
+			part += 10 * (int(r-geezTen) + 1)
+			hasTens = true
+		case r == geezHundred:
+			if part == 0 {
+				part = 1
+			}
+			section += part * 100
+			part, hasTens, hasOnes = 0, false, false
+		case r == geezTenThousand:
+			group := total + section + part
+			if group == 0 {
+				group = 1
+			}
+			total = group * 10000
+			section, part, hasTens, hasOnes = 0, 0, false, false
+		default:
+			return 0, ErrInvalidGeez
+		}
+	}
+	if empty {
+		return 0, ErrInvalidGeez
+	}
+	n := total + section + part
+	if canonical, _ := ToGeez(n); canonical != s {
+		return 0, ErrInvalidGeez
+	}
+	return n, nil
+}
+
+// ToGeez writes n in Ge'ez numerals, the inverse of FromGeez: 2016 is
+// ፳፻፲፮. n is split into pairs of digits separated by ፻ and ፼, and a
+// leading one before ፻ or ፼ is left implicit, so 100 is ፻ and 10000 is ፼.
+// It returns ErrInvalidGeez for n < 1.
+func ToGeez(n int) (string, error) {
+	if n < 1 {
+		return "", ErrInvalidGeez
+	}
+	var groups []int // pairs of decimal digits, least significant first
+	for ; n > 0; n /= 100 {
+		groups = append(groups, n%100)
+	}
+	var b strings.Builder
+	for i := len(groups) - 1; i >= 0; i-- {
+		v := groups[i]
+		// A one before ፻ is always implicit; before ፼ only at the start,
+		// where it cannot be read as part of a preceding ፻ group.
+		leading := b.Len() == 0
+		if v > 1 || v == 1 && (i == 0 || i%2 == 0 && !leading) {
+			if tens := v / 10; tens > 0 {
+				b.WriteRune(geezTen + rune(tens) - 1)
+			}
+			if ones := v % 10; ones > 0 {
+				b.WriteRune(geezOne + rune(ones) - 1)
+			}
+		}
+		switch {
+		case i%2 == 1 && v > 0:
+			b.WriteRune(geezHundred)
+		case i > 0 && i%2 == 0 && (v > 0 || !leading):
+			b.WriteRune(geezTenThousand)
+		}
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,99 @@
+package ethiopiancalendar
+
+import "testing"
+
+func TestFromGeez(t *testing.T) {
+	tests := []struct {
+		in   string
+		want int
+	}{
+		{"፩", 1},
+		{"፱", 9},
+		{"፲", 10},
+		{"፲፩", 11},
+		{"፺፱", 99},
+		{"፻", 100},
+		{"፻፩", 101},
+		{"፳፻፲፮", 2016},
+		{"፲፱፻፹፯", 1987},
+		{"፼", 10000},
+		{"፼፻", 10100},
+		{"፻፼", 1000000},
+		{"፲፼፳", 100020},
+	}
+	for _, tt := range tests {
+		got, err := FromGeez(tt.in)
+		if err != nil {
+			t.Errorf("FromGeez(%q) returned error: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("FromGeez(%q) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestFromGeezDate(t *testing.T) {
+	// ፲፩ ጥር ፳፻፲፮: 11 Tir 2016 written entirely in Ge'ez numerals.
+	day, err := FromGeez("፲፩")
+	if err != nil {
+		t.Fatal(err)
+	}
+	month, err := FromGeez("፭")
+	if err != nil {
+		t.Fatal(err)
+	}
+	year, err := FromGeez("፳፻፲፮")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := (EtDate{Year: year, Month: month, Day: day}); d != (EtDate{Year: 2016, Month: 5, Day: 11}) || d.Validate() != nil {
+		t.Errorf("Got %+v", d)
+	}
+}
+
+func TestFromGeezInvalid(t *testing.T) {
+	// ፻፻ and ፼፻፻ repeat a ፻ without a coefficient, and ፩፻ spells out
+	// the implicit one; ToGeez writes 200, 10200 and 100 as ፪፻, ፼፪፻ and ፻.
+	for _, in := range []string{"", "2016", "፩፩", "፩፲", "፲፲", "፳፻x", "፻፻", "፼፻፻", "፩፻"} {
+		if got, err := FromGeez(in); err == nil {
+			t.Errorf("FromGeez(%q) = %d, expected error", in, got)
+		}
+	}
+}
+
+func TestToGeez(t *testing.T) {
+	tests := []struct {
+		in   int
+		want string
+	}{
+		{1, "፩"},
+		{10, "፲"},
+		{99, "፺፱"},
+		{100, "፻"},
+		{101, "፻፩"},
+		{2016, "፳፻፲፮"},
+		{10000, "፼"},
+		{10100, "፼፻"},
+		{1000000, "፻፼"},
+		{1010000, "፻፩፼"},
+		{100020, "፲፼፳"},
+	}
+	for _, tt := range tests {
+		if got, err := ToGeez(tt.in); err != nil || got != tt.want {
+			t.Errorf("ToGeez(%d) = %q, %v, want %q", tt.in, got, err, tt.want)
+		}
+	}
+	for n := 1; n <= 1000000; n += 37 {
+		s, err := ToGeez(n)
+		if err != nil {
+			t.Fatalf("ToGeez(%d) error: %v", n, err)
+		}
+		if back, err := FromGeez(s); err != nil || back != n {
+			t.Fatalf("FromGeez(ToGeez(%d) = %q) = %d, %v", n, s, back, err)
+		}
+	}
+	if _, err := ToGeez(0); err != ErrInvalidGeez {
+		t.Errorf("ToGeez(0) error = %v, want ErrInvalidGeez", err)
+	}
+}
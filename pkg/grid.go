@@ -0,0 +1,132 @@
+package ethiopiancalendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// CalendarOptions configures text calendars rendered by FormatYear.
+type CalendarOptions struct {
+	// StartOfWeek is the weekday shown in the first column of each month.
+	StartOfWeek time.Weekday
+	// Columns is the number of months per row; 0 means 3.
+	Columns int
+	// Locale selects month and weekday names, as for FormatLocale.
+	Locale string
+}
+
+// monthWidth is the width of a rendered month: seven two-character day
+// cells separated by spaces.
+const monthWidth = 7*3 - 1
+
+// FormatYear renders all 13 months of the Ethiopian year as a plain-text
+// wall calendar, opts.Columns months per row, in the style of the Unix cal
+// command. Pagume, with its five or six days, takes one or two week rows.
+// Lines end in '\n' and carry no trailing spaces.
+func FormatYear(year int, opts CalendarOptions) (string, error) {
+	if year <= 0 {
+		return "", ErrInvalidYear
+	}
+	columns := opts.Columns
+	if columns == 0 {
+		columns = 3
+	}
+	if columns < 0 || columns > 13 {
+		return "", fmt.Errorf("columns must be between 1 and 13, got %d", columns)
+	}
+
+	var b strings.Builder
+	title := EtDate{Year: year, Month: 1, Day: 1}.FormatLocale("YYYY Era", opts.Locale)
+	writeLine(&b, center(title, columns*monthWidth+(columns-1)*2))
+	for first := 1; first <= 13; first += columns {
+		var blocks [][]string
+		height := 0
+		for month := first; month < first+columns && month <= 13; month++ {
+			block := formatMonth(year, month, opts)
+			blocks = append(blocks, block)
+			height = max(height, len(block))
+		}
+		b.WriteByte('\n')
+		for i := range height {
+			var line strings.Builder
+			for j, block := range blocks {
+				if j > 0 {
+					line.WriteString("  ")
+				}
+				cell := ""
+				if i < len(block) {
+					cell = block[i]
+				}
+				line.WriteString(cell)
+				line.WriteString(strings.Repeat(" ", monthWidth-utf8.RuneCountInString(cell)))
+			}
+			writeLine(&b, line.String())
+		}
+	}
+	return b.String(), nil
+}
+
+// formatMonth returns the lines of one month: its name, the weekday header
+// and one line per week, each monthWidth characters wide.
+func formatMonth(year, month int, opts CalendarOptions) []string {
+	first := EtDate{Year: year, Month: month, Day: 1}
+	lines := []string{center(first.FormatLocale("Month", opts.Locale), monthWidth)}
+
+	var header []string
+	for i := range 7 {
+		wd := (opts.StartOfWeek + time.Weekday(i)) % 7
+		if opts.Locale == LocaleAmharic {
+			// Amharic weekdays are abbreviated to their first syllable.
+			r, _ := utf8.DecodeRuneInString(amharicWeekdayNames[wd])
+			header = append(header, " "+string(r))
+		} else {
+			header = append(header, wd.String()[:2])
+		}
+	}
+	lines = append(lines, strings.Join(header, " "))
+
+	grid, _ := MonthGrid(year, month, opts.StartOfWeek)
+	for _, row := range grid {
+		cells := make([]string, 7)
+		for i, day := range row {
+			cells[i] = "  "
+			if day != 0 {
+				cells[i] = fmt.Sprintf("%2d", day)
+			}
+		}
+		lines = append(lines, strings.TrimRight(strings.Join(cells, " "), " "))
+	}
+	return lines
+}
+
+// MonthGrid lays out the given Ethiopian month as a wall calendar: one row
+// per week, with the days of the month in the columns of their weekdays and
+// 0 in the cells before day 1 and after the last day. Weeks begin on
+// startOfWeek if it is given and on DefaultCalendar.WeekStart otherwise.
+func MonthGrid(year, month int, startOfWeek ...time.Weekday) ([][7]int, error) {
+	lead, err := FirstWeekdayOfMonth(year, month, startOfWeek...)
+	if err != nil {
+		return nil, err
+	}
+	days := DaysInMonth(year, month)
+	grid := make([][7]int, (lead+days+6)/7)
+	for day := 1; day <= days; day++ {
+		cell := lead + day - 1
+		grid[cell/7][cell%7] = day
+	}
+	return grid, nil
+}
+
+// center pads s with leading spaces to center it within width characters.
+func center(s string, width int) string {
+	pad := (width - utf8.RuneCountInString(s)) / 2
+	return strings.Repeat(" ", max(pad, 0)) + s
+}
+
+// writeLine writes s without trailing spaces, followed by a newline.
+func writeLine(b *strings.Builder, s string) {
+	b.WriteString(strings.TrimRight(s, " "))
+	b.WriteByte('\n')
+}
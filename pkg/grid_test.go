@@ -0,0 +1,135 @@
+package ethiopiancalendar
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files in testdata")
+
+func TestFormatYearGolden(t *testing.T) {
+	// 2015 is a leap year, so Pagume has six days.
+	got, err := FormatYear(2015, CalendarOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden := filepath.Join("testdata", "year2015.golden")
+	if *update {
+		if err := os.WriteFile(golden, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Errorf("FormatYear(2015) differs from %s; run go test -update to see the change.\nGot:\n%s", golden, got)
+	}
+}
+
+func TestFormatYearOptions(t *testing.T) {
+	got, err := FormatYear(2016, CalendarOptions{StartOfWeek: time.Monday, Columns: 13, Locale: LocaleAmharic})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSuffix(got, "\n"), "\n")
+	// Title, blank line, month names, weekday header and six week rows.
+	if len(lines) != 10 {
+		t.Fatalf("FormatYear with 13 columns has %d lines, want 10:\n%s", len(lines), got)
+	}
+	if !strings.Contains(lines[0], "2016 ዓ.ም.") || !strings.Contains(lines[2], "ጳጉሜን") {
+		t.Errorf("Expected Amharic title and month names:\n%s", got)
+	}
+	if !strings.HasPrefix(lines[3], " ሰ  ማ") {
+		t.Errorf("Expected weeks to start on Monday (ሰኞ), got header %q", lines[3])
+	}
+	for _, line := range lines {
+		if strings.HasSuffix(line, " ") {
+			t.Errorf("Line %q has trailing spaces", line)
+		}
+	}
+
+	if _, err := FormatYear(0, CalendarOptions{}); err == nil {
+		t.Error("Expected error for year 0")
+	}
+	if _, err := FormatYear(2016, CalendarOptions{Columns: 14}); err == nil {
+		t.Error("Expected error for 14 columns")
+	}
+}
+
+func TestMonthGridStartOfWeek(t *testing.T) {
+	// Meskerem 1, 2016 is a Tuesday.
+	sunday := [][7]int{
+		{0, 0, 1, 2, 3, 4, 5},
+		{6, 7, 8, 9, 10, 11, 12},
+		{13, 14, 15, 16, 17, 18, 19},
+		{20, 21, 22, 23, 24, 25, 26},
+		{27, 28, 29, 30, 0, 0, 0},
+	}
+	monday := [][7]int{
+		{0, 1, 2, 3, 4, 5, 6},
+		{7, 8, 9, 10, 11, 12, 13},
+		{14, 15, 16, 17, 18, 19, 20},
+		{21, 22, 23, 24, 25, 26, 27},
+		{28, 29, 30, 0, 0, 0, 0},
+	}
+	if got, err := MonthGrid(2016, 1, time.Sunday); err != nil || !slices.Equal(got, sunday) {
+		t.Errorf("MonthGrid(2016, 1, Sunday) = %v, %v, want %v", got, err, sunday)
+	}
+	if got, err := MonthGrid(2016, 1, time.Monday); err != nil || !slices.Equal(got, monday) {
+		t.Errorf("MonthGrid(2016, 1, Monday) = %v, %v, want %v", got, err, monday)
+	}
+
+	// Without an override the grid follows DefaultCalendar.WeekStart.
+	if got, err := MonthGrid(2016, 1); err != nil || !slices.Equal(got, sunday) {
+		t.Errorf("MonthGrid(2016, 1) = %v, %v, want the Sunday-start grid", got, err)
+	}
+	defer func(start time.Weekday) { DefaultCalendar.WeekStart = start }(DefaultCalendar.WeekStart)
+	DefaultCalendar.WeekStart = time.Monday
+	if got, err := MonthGrid(2016, 1); err != nil || !slices.Equal(got, monday) {
+		t.Errorf("MonthGrid(2016, 1) with a Monday default = %v, %v, want the Monday-start grid", got, err)
+	}
+	if got, err := NewCalendar(time.Sunday, LocaleEnglish).MonthGrid(2016, 1); err != nil || !slices.Equal(got, sunday) {
+		t.Errorf("Calendar.MonthGrid(2016, 1) = %v, %v, want the Sunday-start grid", got, err)
+	}
+
+	// The rendered month lines up with the grid.
+	render := func(start time.Weekday) string {
+		return strings.Join(formatMonth(2016, 1, CalendarOptions{StartOfWeek: start}), "\n")
+	}
+	wantSunday := strings.Join([]string{
+		"      Meskerem",
+		"Su Mo Tu We Th Fr Sa",
+		"       1  2  3  4  5",
+		" 6  7  8  9 10 11 12",
+		"13 14 15 16 17 18 19",
+		"20 21 22 23 24 25 26",
+		"27 28 29 30",
+	}, "\n")
+	wantMonday := strings.Join([]string{
+		"      Meskerem",
+		"Mo Tu We Th Fr Sa Su",
+		"    1  2  3  4  5  6",
+		" 7  8  9 10 11 12 13",
+		"14 15 16 17 18 19 20",
+		"21 22 23 24 25 26 27",
+		"28 29 30",
+	}, "\n")
+	if got := render(time.Sunday); got != wantSunday {
+		t.Errorf("Sunday-start Meskerem 2016:\n%s\nwant:\n%s", got, wantSunday)
+	}
+	if got := render(time.Monday); got != wantMonday {
+		t.Errorf("Monday-start Meskerem 2016:\n%s\nwant:\n%s", got, wantMonday)
+	}
+
+	if _, err := MonthGrid(2016, 14); !errors.Is(err, ErrInvalidMonth) {
+		t.Errorf("MonthGrid(2016, 14) error = %v, want ErrInvalidMonth", err)
+	}
+}
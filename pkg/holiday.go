@@ -0,0 +1,79 @@
+package ethiopiancalendar
+
+import "io"
+
+// Holiday is a named public holiday on a specific Ethiopian date.
+type Holiday struct {
+	Date EtDate
+	Name string
+}
+
+type fixedHoliday struct {
+	month, day int
+	name       string
+}
+
+// fixedHolidays lists the public holidays that fall on the same Ethiopian
+// month and day every year, in calendar order. Holidays tied to the Gregorian
+// calendar or to the lunar and Fasika cycles are not included.
+var fixedHolidays = []fixedHoliday{
+	{1, 1, "Enkutatash"},
+	{1, 17, "Meskel"},
+	{4, 29, "Genna"},
+	{5, 11, "Timket"},
+	{6, 23, "Adwa Victory Day"},
+	{8, 27, "Patriots' Victory Day"},
+	{9, 20, "Downfall of the Derg"},
+}
+
+// RegisterHoliday adds a holiday observed every year on the given Ethiopian
+// month and day, alongside the built-in fixed holidays. Registering the same
+// month and day again replaces the earlier name. A holiday on Pagume 6 is
+// only observed in leap years. It registers with DefaultCalendar.
+func RegisterHoliday(month, day int, name string) error {
+	return DefaultCalendar.RegisterHoliday(month, day, name)
+}
+
+// LoadHolidays registers with DefaultCalendar the holidays read from r, a
+// JSON array of objects with "month", "day" and "name" fields, such as a
+// region-specific list loaded at startup. Each entry is registered as with
+// RegisterHoliday, so it extends the built-in holidays and replaces an
+// earlier registration on the same day. An entry may set "type" to "fixed";
+// other types, such as movable feasts, are rejected. Nothing is registered
+// unless every entry is valid.
+func LoadHolidays(r io.Reader) error {
+	return DefaultCalendar.LoadHolidays(r)
+}
+
+// HolidaysInYear returns the fixed and registered holidays of the given
+// Ethiopian year in chronological order. Genna moves to Tahsas 28 in years
+// that follow a leap year, so that it stays on the same day as the 7 January
+// observance.
+func HolidaysInYear(year int) ([]Holiday, error) {
+	return DefaultCalendar.HolidaysInYear(year)
+}
+
+// IsHoliday reports whether the date is a fixed or registered holiday.
+func (d EtDate) IsHoliday() (bool, error) {
+	return DefaultCalendar.IsHoliday(d)
+}
+
+// HolidaysBetween returns the fixed holidays from start to end inclusive, in
+// chronological order. The range may span several years.
+func HolidaysBetween(start, end EtDate) ([]Holiday, error) {
+	return DefaultCalendar.HolidaysBetween(start, end)
+}
+
+// MeskelGregorian returns the Gregorian date of Meskel (Meskerem 17) in the
+// given Gregorian year. Meskel falls in late September, in the Ethiopian
+// year that begins that month.
+func MeskelGregorian(gregYear int) (GregorianDate, error) {
+	return GregorianOfEthiopianMonthDay(1, 17, gregYear)
+}
+
+// TimketGregorian returns the Gregorian date of Timket (Tir 11) in the given
+// Gregorian year. Timket falls in January, in the Ethiopian year that began
+// the previous September.
+func TimketGregorian(gregYear int) (GregorianDate, error) {
+	return GregorianOfEthiopianMonthDay(5, 11, gregYear)
+}
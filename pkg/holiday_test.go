@@ -0,0 +1,222 @@
+package ethiopiancalendar
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestHolidaysInYear(t *testing.T) {
+	holidays, err := HolidaysInYear(2017)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(holidays) != len(fixedHolidays) {
+		t.Fatalf("Expected %d holidays, got %d", len(fixedHolidays), len(holidays))
+	}
+	if holidays[0] != (Holiday{Date: EtDate{Year: 2017, Month: 1, Day: 1}, Name: "Enkutatash"}) {
+		t.Errorf("Unexpected first holiday %+v", holidays[0])
+	}
+
+	// Genna is on 7 January in both years, which is Tahsas 28 after a leap year.
+	for year, want := range map[int]EtDate{2016: {Year: 2016, Month: 4, Day: 28}, 2017: {Year: 2017, Month: 4, Day: 29}} {
+		holidays, err := HolidaysInYear(year)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if holidays[2].Name != "Genna" || holidays[2].Date != want {
+			t.Errorf("Genna %d: got %+v, want %+v", year, holidays[2], want)
+		}
+	}
+
+	if _, err := HolidaysInYear(0); err == nil {
+		t.Error("Expected error for year 0")
+	}
+}
+
+func TestHolidaysBetween(t *testing.T) {
+	start := EtDate{Year: 2016, Month: 5, Day: 1}
+	end := EtDate{Year: 2017, Month: 1, Day: 30}
+	holidays, err := HolidaysBetween(start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"Timket", "Adwa Victory Day", "Patriots' Victory Day", "Downfall of the Derg", "Enkutatash", "Meskel"}
+	if len(holidays) != len(want) {
+		t.Fatalf("Expected %d holidays, got %+v", len(want), holidays)
+	}
+	for i, name := range want {
+		if holidays[i].Name != name {
+			t.Errorf("Holiday %d: got %q, want %q", i, holidays[i].Name, name)
+		}
+	}
+	if holidays[4].Date != (EtDate{Year: 2017, Month: 1, Day: 1}) {
+		t.Errorf("Expected Enkutatash 2017, got %+v", holidays[4].Date)
+	}
+
+	if _, err := HolidaysBetween(end, start); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("Expected ErrInvalidRange for reversed range, got %v", err)
+	}
+}
+
+func TestHolidaysBetweenInclusive(t *testing.T) {
+	meskel := EtDate{Year: 2016, Month: 1, Day: 17}
+	holidays, err := HolidaysBetween(meskel, meskel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(holidays) != 1 || holidays[0].Name != "Meskel" {
+		t.Errorf("Expected only Meskel, got %+v", holidays)
+	}
+}
+
+// resetCustomHolidays removes holidays registered by a test.
+func resetCustomHolidays(t *testing.T) {
+	t.Cleanup(func() {
+		DefaultCalendar.mu.Lock()
+		DefaultCalendar.holidays = nil
+		DefaultCalendar.mu.Unlock()
+	})
+}
+
+func TestRegisterHoliday(t *testing.T) {
+	resetCustomHolidays(t)
+
+	founders := EtDate{Year: 2016, Month: 3, Day: 5}
+	if ok, err := founders.IsHoliday(); err != nil || ok {
+		t.Fatalf("Expected Hidar 5 not to be a holiday before registering, got %v, %v", ok, err)
+	}
+	if err := RegisterHoliday(3, 5, "Founders' Day"); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := founders.IsHoliday(); err != nil || !ok {
+		t.Errorf("Expected Hidar 5 to be a holiday, got %v, %v", ok, err)
+	}
+	if ok, err := (EtDate{Year: 2016, Month: 1, Day: 17}).IsHoliday(); err != nil || !ok {
+		t.Errorf("Expected built-in Meskel to remain a holiday, got %v, %v", ok, err)
+	}
+
+	holidays, err := HolidaysInYear(2016)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if holidays[2].Name != "Founders' Day" {
+		t.Errorf("Expected registered holiday in chronological position, got %+v", holidays)
+	}
+
+	if err := RegisterHoliday(3, 5, "Company Day"); err != nil {
+		t.Fatal(err)
+	}
+	if holidays, _ := HolidaysInYear(2016); len(holidays) != len(fixedHolidays)+1 || holidays[2].Name != "Company Day" {
+		t.Errorf("Expected re-registration to replace the name, got %+v", holidays)
+	}
+}
+
+func TestRegisterHolidayPagume6(t *testing.T) {
+	resetCustomHolidays(t)
+
+	if err := RegisterHoliday(13, 6, "Leap Day"); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := (EtDate{Year: 2015, Month: 13, Day: 6}).IsHoliday(); !ok {
+		t.Error("Expected Pagume 6 2015 to be a holiday")
+	}
+	if holidays, _ := HolidaysInYear(2016); len(holidays) != len(fixedHolidays) {
+		t.Errorf("Expected no Pagume 6 holiday in a common year, got %+v", holidays)
+	}
+}
+
+func TestRegisterHolidayInvalid(t *testing.T) {
+	resetCustomHolidays(t)
+
+	tests := []struct {
+		month, day int
+		name       string
+	}{
+		{14, 1, "Nope"},
+		{1, 31, "Nope"},
+		{13, 7, "Nope"},
+		{1, 2, ""},
+	}
+	for _, tt := range tests {
+		if err := RegisterHoliday(tt.month, tt.day, tt.name); err == nil {
+			t.Errorf("RegisterHoliday(%d, %d, %q) expected error", tt.month, tt.day, tt.name)
+		}
+	}
+}
+
+func TestLoadHolidays(t *testing.T) {
+	resetCustomHolidays(t)
+
+	blob := `[
+		{"month": 3, "day": 10, "name": "Founders' Day"},
+		{"month": 13, "day": 6, "name": "Leap Day", "type": "fixed"}
+	]`
+	if err := LoadHolidays(strings.NewReader(blob)); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		date EtDate
+		want bool
+	}{
+		{EtDate{Year: 2016, Month: 3, Day: 10}, true},
+		{EtDate{Year: 2015, Month: 13, Day: 6}, true},
+		{EtDate{Year: 2016, Month: 3, Day: 11}, false},
+		{EtDate{Year: 2016, Month: 1, Day: 1}, true},
+	}
+	for _, tt := range tests {
+		if got, err := tt.date.IsHoliday(); err != nil || got != tt.want {
+			t.Errorf("IsHoliday(%v) = %v, %v, want %v", tt.date, got, err, tt.want)
+		}
+	}
+}
+
+func TestLoadHolidaysInvalid(t *testing.T) {
+	resetCustomHolidays(t)
+
+	tests := []struct {
+		blob string
+		want error
+	}{
+		{`[{"month": 14, "day": 1, "name": "Bad"}]`, ErrInvalidMonth},
+		{`[{"month": 3, "day": 10, "name": "Good"}, {"month": 2, "day": 31, "name": "Bad"}]`, ErrInvalidDay},
+		{`[{"month": 3, "day": 10, "name": "Fasika", "type": "movable"}]`, nil},
+		{`{"month": 3}`, nil},
+		{`[{"month": 3, "day": 10}]`, nil},
+	}
+	for _, tt := range tests {
+		err := LoadHolidays(strings.NewReader(tt.blob))
+		if err == nil {
+			t.Errorf("LoadHolidays(%s) succeeded, expected error", tt.blob)
+		} else if tt.want != nil && !errors.Is(err, tt.want) {
+			t.Errorf("LoadHolidays(%s) error = %v, want %v", tt.blob, err, tt.want)
+		}
+	}
+
+	// A failed load registers nothing, not even its valid entries.
+	if got, _ := (EtDate{Year: 2016, Month: 3, Day: 10}).IsHoliday(); got {
+		t.Error("Hidar 10 registered by a failed load")
+	}
+}
+
+func TestMeskelAndTimketGregorian(t *testing.T) {
+	tests := []struct {
+		gregYear       int
+		meskel, timket GregorianDate
+	}{
+		// Timket 2024 is in Ethiopian year 2016, Meskel 2024 in 2017.
+		{2024, GregorianDate{Year: 2024, Month: 9, Day: 27}, GregorianDate{Year: 2024, Month: 1, Day: 20}},
+		{2023, GregorianDate{Year: 2023, Month: 9, Day: 28}, GregorianDate{Year: 2023, Month: 1, Day: 19}},
+		{2025, GregorianDate{Year: 2025, Month: 9, Day: 27}, GregorianDate{Year: 2025, Month: 1, Day: 19}},
+	}
+	for _, tt := range tests {
+		if got, err := MeskelGregorian(tt.gregYear); err != nil || got != tt.meskel {
+			t.Errorf("MeskelGregorian(%d) = %v, %v, want %v", tt.gregYear, got, err, tt.meskel)
+		}
+		if got, err := TimketGregorian(tt.gregYear); err != nil || got != tt.timket {
+			t.Errorf("TimketGregorian(%d) = %v, %v, want %v", tt.gregYear, got, err, tt.timket)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package ethiopiancalendar
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"unicode/utf8"
+)
+
+// HolidaysToICS renders the holidays of the given Ethiopian year as an
+// iCalendar (RFC 5545) VCALENDAR with one all-day VEVENT per holiday, dated
+// by its Gregorian equivalent and stamped with the time of generation. Lines
+// end in CRLF and are folded at 75 octets as the format requires.
+func HolidaysToICS(year int) (string, error) {
+	holidays, err := HolidaysInYear(year)
+	if err != nil {
+		return "", err
+	}
+
+	dtstamp := now().UTC().Format("20060102T150405Z")
+	var b strings.Builder
+	b.WriteString("BEGIN:VCALENDAR\r\n")
+	b.WriteString("VERSION:2.0\r\n")
+	b.WriteString("PRODID:-//mel-ak//ethiopiancalendar//EN\r\n")
+	b.WriteString("CALSCALE:GREGORIAN\r\n")
+	for _, h := range holidays {
+		start, err := h.Date.ToJDN()
+		if err != nil {
+			return "", err
+		}
+		dtstart, err := icsDate(start)
+		if err != nil {
+			return "", err
+		}
+		dtend, err := icsDate(start + 1)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString("BEGIN:VEVENT\r\n")
+		writeICSLine(&b, fmt.Sprintf("UID:%s-%s@ethiopiancalendar", h.Date.CSV(), icsSlug(h.Name)))
+		fmt.Fprintf(&b, "DTSTAMP:%s\r\n", dtstamp)
+		fmt.Fprintf(&b, "DTSTART;VALUE=DATE:%s\r\n", dtstart)
+		fmt.Fprintf(&b, "DTEND;VALUE=DATE:%s\r\n", dtend)
+		writeICSLine(&b, "SUMMARY:"+icsEscape(h.Name))
+		b.WriteString("END:VEVENT\r\n")
+	}
+	b.WriteString("END:VCALENDAR\r\n")
+	return b.String(), nil
+}
+
+// icsDate formats the Gregorian date of a JDN as an iCalendar DATE value.
+func icsDate(jdn int) (string, error) {
+	gy, gm, gd, err := JDNToGregorian(jdn)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%04d%02d%02d", gy, gm, gd), nil
+}
+
+// icsLineOctets is the longest a content line may be before it is folded.
+const icsLineOctets = 75
+
+// writeICSLine writes line to b, followed by CRLF, folding it onto
+// continuation lines that start with a space whenever it would exceed
+// icsLineOctets. It never splits a UTF-8 encoded character.
+func writeICSLine(b *strings.Builder, line string) {
+	limit := icsLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// The leading space counts towards the continuation line's length.
+		limit = icsLineOctets - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+// icsSlug lowercases name and replaces every run of other characters than
+// ASCII letters and digits with a hyphen, for use in a UID. A name with no
+// ASCII letters or digits, such as an Amharic one, is identified by a hash
+// of its text instead, so distinct names still get distinct UIDs.
+func icsSlug(name string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			hyphen = false
+		} else if !hyphen && b.Len() > 0 {
+			b.WriteByte('-')
+			hyphen = true
+		}
+	}
+	if slug := strings.TrimSuffix(b.String(), "-"); slug != "" {
+		return slug
+	}
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	return fmt.Sprintf("h%08x", h.Sum32())
+}
+
+// icsEscape escapes text for an iCalendar TEXT value.
+var icsEscape = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace
@@ -0,0 +1,88 @@
+package ethiopiancalendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestHolidaysToICS(t *testing.T) {
+	// DTSTAMP is the generation time in UTC, not the event date.
+	setNow(t, time.Date(2024, time.March, 5, 9, 30, 15, 0, time.FixedZone("EAT", 3*60*60)))
+	ics, err := HolidaysToICS(2016)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(ics, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n") || !strings.HasSuffix(ics, "END:VCALENDAR\r\n") {
+		t.Errorf("Output is not wrapped in a VCALENDAR:\n%s", ics)
+	}
+	if got := strings.Count(ics, "BEGIN:VEVENT\r\n"); got != len(fixedHolidays) {
+		t.Errorf("Expected %d VEVENTs, got %d", len(fixedHolidays), got)
+	}
+	if got := strings.Count(ics, "END:VEVENT\r\n"); got != len(fixedHolidays) {
+		t.Errorf("Expected %d closed VEVENTs, got %d", len(fixedHolidays), got)
+	}
+
+	enkutatash := "BEGIN:VEVENT\r\n" +
+		"UID:2016-01-01-enkutatash@ethiopiancalendar\r\n" +
+		"DTSTAMP:20240305T063015Z\r\n" +
+		"DTSTART;VALUE=DATE:20230912\r\n" +
+		"DTEND;VALUE=DATE:20230913\r\n" +
+		"SUMMARY:Enkutatash\r\n" +
+		"END:VEVENT\r\n"
+	if !strings.Contains(ics, enkutatash) {
+		t.Errorf("Expected Enkutatash event, got:\n%s", ics)
+	}
+	if !strings.Contains(ics, "UID:2016-08-27-patriots-victory-day@ethiopiancalendar\r\n") {
+		t.Errorf("Expected slugged UID for Patriots' Victory Day, got:\n%s", ics)
+	}
+
+	if _, err := HolidaysToICS(0); err == nil {
+		t.Error("Expected error for year 0")
+	}
+}
+
+func TestICSEscape(t *testing.T) {
+	if got := icsEscape(`Eid, Feast; Day\`); got != `Eid\, Feast\; Day\\` {
+		t.Errorf("icsEscape = %q", got)
+	}
+}
+
+func TestICSFolding(t *testing.T) {
+	resetCustomHolidays(t)
+	long := strings.Repeat("የኢትዮጵያ ብሔራዊ በዓል ", 6)
+	if err := RegisterHoliday(2, 3, long); err != nil {
+		t.Fatal(err)
+	}
+	ics, err := HolidaysToICS(2016)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(ics, "\r\n"), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("Line of %d octets is not folded: %q", len(line), line)
+		}
+		if !utf8.ValidString(line) {
+			t.Errorf("Folding split a character: %q", line)
+		}
+	}
+	if unfolded := strings.ReplaceAll(ics, "\r\n ", ""); !strings.Contains(unfolded, "SUMMARY:"+long+"\r\n") {
+		t.Errorf("Unfolded output lacks the long SUMMARY:\n%s", ics)
+	}
+}
+
+func TestICSSlug(t *testing.T) {
+	if got := icsSlug("Patriots' Victory Day"); got != "patriots-victory-day" {
+		t.Errorf("icsSlug = %q", got)
+	}
+	// Names without ASCII letters fall back to a stable hash.
+	a, b := icsSlug("መስቀል"), icsSlug("ጥምቀት")
+	if a == "" || b == "" || a == b {
+		t.Errorf("icsSlug of Amharic names = %q and %q, want distinct non-empty slugs", a, b)
+	}
+	if again := icsSlug("መስቀል"); again != a {
+		t.Errorf("icsSlug is not stable: %q then %q", a, again)
+	}
+}
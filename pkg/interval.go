@@ -0,0 +1,44 @@
+package ethiopiancalendar
+
+import "fmt"
+
+// Interval is a calendar duration of whole years, months and days. Its
+// fields may be negative.
+type Interval struct {
+	Years, Months, Days int
+}
+
+// Add returns d moved forward by iv. The years are applied first, then the
+// months, then the days, with AddYears, AddMonths and AddDays; a day that
+// does not exist after the year or month step is clamped to the end of the
+// month, as those methods do.
+func (d EtDate) Add(iv Interval) (EtDate, error) {
+	if err := d.Validate(); err != nil {
+		return EtDate{}, err
+	}
+	return d.AddYears(iv.Years).AddMonths(iv.Months).AddDays(iv.Days)
+}
+
+// SubtractInterval returns d moved back by iv, undoing the steps of Add in
+// reverse order: the days first, then the months, then the years. A result
+// before Meskerem 1 of year 1 is an error wrapping ErrBeforeEpoch and
+// ErrInvalidYear.
+//
+// Because the month and year steps clamp the day, d.Add(iv) followed by
+// SubtractInterval(iv) does not always return d. For example Pagume 6, 2015
+// plus 13 months clamps to Pagume 5, 2016, and 13 months before that is
+// Pagume 5, 2015.
+func (d EtDate) SubtractInterval(iv Interval) (EtDate, error) {
+	if err := d.Validate(); err != nil {
+		return EtDate{}, err
+	}
+	d, err := d.AddDays(-iv.Days)
+	if err != nil {
+		return EtDate{}, err
+	}
+	result := d.AddMonths(-iv.Months).AddYears(-iv.Years)
+	if err := result.Validate(); err != nil {
+		return EtDate{}, fmt.Errorf("subtracting %+v from %s would precede the calendar epoch, Meskerem 1 of year 1: %w: %w", iv, d.CSV(), ErrBeforeEpoch, err)
+	}
+	return result, nil
+}
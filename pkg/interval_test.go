@@ -0,0 +1,102 @@
+package ethiopiancalendar
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAddInterval(t *testing.T) {
+	tests := []struct {
+		date EtDate
+		iv   Interval
+		want EtDate
+	}{
+		{EtDate{Year: 2016, Month: 1, Day: 1}, Interval{}, EtDate{Year: 2016, Month: 1, Day: 1}},
+		{EtDate{Year: 2016, Month: 1, Day: 1}, Interval{Years: 1, Months: 2, Days: 3}, EtDate{Year: 2017, Month: 3, Day: 4}},
+		// Months before days: 12/30 + 1 month clamps to Pagume 5, then +1 day.
+		{EtDate{Year: 2016, Month: 12, Day: 30}, Interval{Months: 1, Days: 1}, EtDate{Year: 2017, Month: 1, Day: 1}},
+		{EtDate{Year: 2015, Month: 13, Day: 6}, Interval{Years: 1}, EtDate{Year: 2016, Month: 13, Day: 5}},
+		{EtDate{Year: 2016, Month: 3, Day: 10}, Interval{Years: -1, Days: -10}, EtDate{Year: 2015, Month: 2, Day: 30}},
+	}
+	for _, tt := range tests {
+		got, err := tt.date.Add(tt.iv)
+		if err != nil {
+			t.Errorf("Add(%v, %+v) returned error: %v", tt.date, tt.iv, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Add(%v, %+v) = %v, want %v", tt.date, tt.iv, got, tt.want)
+		}
+	}
+}
+
+func TestSubtractInterval(t *testing.T) {
+	tests := []struct {
+		date EtDate
+		iv   Interval
+		want EtDate
+	}{
+		{EtDate{Year: 2017, Month: 3, Day: 4}, Interval{Years: 1, Months: 2, Days: 3}, EtDate{Year: 2016, Month: 1, Day: 1}},
+		// Days before months: 1/1 - 1 day is Pagume 5, then -1 month.
+		{EtDate{Year: 2017, Month: 1, Day: 1}, Interval{Months: 1, Days: 1}, EtDate{Year: 2016, Month: 12, Day: 5}},
+		{EtDate{Year: 2016, Month: 13, Day: 5}, Interval{Years: 1}, EtDate{Year: 2015, Month: 13, Day: 5}},
+	}
+	for _, tt := range tests {
+		got, err := tt.date.SubtractInterval(tt.iv)
+		if err != nil {
+			t.Errorf("SubtractInterval(%v, %+v) returned error: %v", tt.date, tt.iv, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("SubtractInterval(%v, %+v) = %v, want %v", tt.date, tt.iv, got, tt.want)
+		}
+	}
+
+	if _, err := (EtDate{Year: 2016, Month: 13, Day: 6}).SubtractInterval(Interval{Days: 1}); err == nil {
+		t.Error("Expected error for an invalid date")
+	}
+	if _, err := (EtDate{Year: 1, Month: 1, Day: 1}).SubtractInterval(Interval{Days: 1}); err == nil {
+		t.Error("Expected error when subtracting before the epoch")
+	}
+	for _, tt := range []struct {
+		date EtDate
+		iv   Interval
+	}{
+		{EtDate{Year: 2, Month: 1, Day: 1}, Interval{Years: 5}},
+		{EtDate{Year: 1, Month: 3, Day: 1}, Interval{Months: 5}},
+	} {
+		_, err := tt.date.SubtractInterval(tt.iv)
+		if !errors.Is(err, ErrBeforeEpoch) || !errors.Is(err, ErrInvalidYear) {
+			t.Errorf("SubtractInterval(%v, %+v) error = %v, want ErrBeforeEpoch and ErrInvalidYear", tt.date, tt.iv, err)
+		}
+	}
+}
+
+// TestIntervalRoundTripNearPagume locks in the documented cases where
+// SubtractInterval does not undo Add because of day clamping.
+func TestIntervalRoundTripNearPagume(t *testing.T) {
+	tests := []struct {
+		date EtDate
+		iv   Interval
+		back EtDate
+	}{
+		{EtDate{Year: 2015, Month: 13, Day: 6}, Interval{Months: 13}, EtDate{Year: 2015, Month: 13, Day: 5}},
+		{EtDate{Year: 2015, Month: 13, Day: 6}, Interval{Years: 1}, EtDate{Year: 2015, Month: 13, Day: 5}},
+		{EtDate{Year: 2016, Month: 12, Day: 30}, Interval{Months: 1, Days: 1}, EtDate{Year: 2016, Month: 12, Day: 5}},
+		// Away from Pagume the round trip holds.
+		{EtDate{Year: 2016, Month: 5, Day: 20}, Interval{Years: 2, Months: 3, Days: 4}, EtDate{Year: 2016, Month: 5, Day: 20}},
+	}
+	for _, tt := range tests {
+		sum, err := tt.date.Add(tt.iv)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := sum.SubtractInterval(tt.iv)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.back {
+			t.Errorf("Add then SubtractInterval(%v, %+v) = %v, want %v", tt.date, tt.iv, got, tt.back)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package ethiopiancalendar
+
+import "strconv"
+
+// Month specifies a month of the Ethiopian year (Meskerem = 1, ...).
+type Month int
+
+// The months of the Ethiopian year.
+const (
+	Meskerem Month = 1 + iota
+	Tikimt
+	Hidar
+	Tahsas
+	Tir
+	Yekatit
+	Megabit
+	Miazia
+	Genbot
+	Sene
+	Hamle
+	Nehase
+	Pagume
+)
+
+// String returns the English transliteration of the month name
+// ("Meskerem", "Tikimt", ...).
+func (m Month) String() string {
+	if m >= Meskerem && m <= Pagume {
+		return monthNames[m]
+	}
+	return "%!Month(" + strconv.Itoa(int(m)) + ")"
+}
+
+// NewEtDateM returns the validated date with the given year, month and day.
+func NewEtDateM(year int, month Month, day int) (EtDate, error) {
+	d := EtDate{Year: year, Month: int(month), Day: day}
+	if err := d.Validate(); err != nil {
+		return EtDate{}, err
+	}
+	return d, nil
+}
+
+// MonthEnum returns the date's month as a Month.
+func (d EtDate) MonthEnum() Month {
+	return Month(d.Month)
+}
@@ -0,0 +1,38 @@
+package ethiopiancalendar
+
+import "testing"
+
+func TestMonthString(t *testing.T) {
+	tests := []struct {
+		month Month
+		want  string
+	}{
+		{Meskerem, "Meskerem"},
+		{Tir, "Tir"},
+		{Pagume, "Pagume"},
+		{Month(0), "%!Month(0)"},
+		{Month(14), "%!Month(14)"},
+	}
+	for _, tt := range tests {
+		if got := tt.month.String(); got != tt.want {
+			t.Errorf("Month(%d).String() = %q, want %q", int(tt.month), got, tt.want)
+		}
+	}
+}
+
+func TestNewEtDateM(t *testing.T) {
+	d, err := NewEtDateM(2016, Tir, 11)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d != (EtDate{Year: 2016, Month: 5, Day: 11}) {
+		t.Errorf("NewEtDateM(2016, Tir, 11) = %+v", d)
+	}
+	if d.MonthEnum() != Tir {
+		t.Errorf("MonthEnum() = %v, want %v", d.MonthEnum(), Tir)
+	}
+
+	if _, err := NewEtDateM(2016, Pagume, 6); err == nil {
+		t.Error("Expected error for Pagume 6 in a common year")
+	}
+}
@@ -0,0 +1,68 @@
+package ethiopiancalendar
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// NullEtDate is an EtDate that may be absent, like sql.NullTime. It
+// implements sql.Scanner and driver.Valuer, storing the date as text in the
+// YYYY-MM-DD form of CSV, and encodes to JSON as that string or null.
+type NullEtDate struct {
+	EtDate EtDate
+	Valid  bool // Valid is true if EtDate is set
+}
+
+// Scan implements sql.Scanner. It accepts nil, a string or a []byte holding
+// a date in the form produced by CSV.
+func (n *NullEtDate) Scan(value any) error {
+	var s string
+	switch v := value.(type) {
+	case nil:
+		*n = NullEtDate{}
+		return nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into NullEtDate", value)
+	}
+	d, err := ParseCSVDate(s)
+	if err != nil {
+		return err
+	}
+	*n = NullEtDate{EtDate: d, Valid: true}
+	return nil
+}
+
+// Value implements driver.Valuer, returning nil when n is not valid.
+func (n NullEtDate) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.EtDate.CSV(), nil
+}
+
+// MarshalJSON encodes n as a "YYYY-MM-DD" string, or null when it is not
+// valid.
+func (n NullEtDate) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.EtDate.CSV())
+}
+
+// UnmarshalJSON decodes null or a "YYYY-MM-DD" string.
+func (n *NullEtDate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*n = NullEtDate{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("NullEtDate: %w", err)
+	}
+	return n.Scan(s)
+}
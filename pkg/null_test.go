@@ -0,0 +1,69 @@
+package ethiopiancalendar
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestNullEtDateJSON(t *testing.T) {
+	type event struct {
+		Date NullEtDate `json:"date"`
+	}
+	tests := []struct {
+		in   event
+		want string
+	}{
+		{event{NullEtDate{EtDate: EtDate{Year: 2016, Month: 1, Day: 1}, Valid: true}}, `{"date":"2016-01-01"}`},
+		{event{}, `{"date":null}`},
+	}
+	for _, tt := range tests {
+		data, err := json.Marshal(tt.in)
+		if err != nil {
+			t.Fatalf("Marshal(%+v) error: %v", tt.in, err)
+		}
+		if string(data) != tt.want {
+			t.Errorf("Marshal(%+v) = %s, want %s", tt.in, data, tt.want)
+		}
+		var back event
+		if err := json.Unmarshal(data, &back); err != nil {
+			t.Fatalf("Unmarshal(%s) error: %v", data, err)
+		}
+		if back != tt.in {
+			t.Errorf("Unmarshal(%s) = %+v, want %+v", data, back, tt.in)
+		}
+	}
+
+	var e event
+	if err := json.Unmarshal([]byte(`{"date":"2016-13-06"}`), &e); !errors.Is(err, ErrInvalidDay) {
+		t.Errorf("Unmarshal of an invalid date error = %v, want ErrInvalidDay", err)
+	}
+	if err := json.Unmarshal([]byte(`{"date":20160101}`), &e); err == nil {
+		t.Error("Expected error for a numeric date")
+	}
+}
+
+func TestNullEtDateSQL(t *testing.T) {
+	valid := NullEtDate{EtDate: EtDate{Year: 2015, Month: 13, Day: 6}, Valid: true}
+	v, err := valid.Value()
+	if err != nil || v != "2015-13-06" {
+		t.Fatalf("Value() = %v, %v, want 2015-13-06", v, err)
+	}
+	if v, err := (NullEtDate{}).Value(); err != nil || v != nil {
+		t.Errorf("Value() of a null date = %v, %v, want nil", v, err)
+	}
+
+	for _, src := range []any{"2015-13-06", []byte("2015-13-06")} {
+		var n NullEtDate
+		if err := n.Scan(src); err != nil || n != valid {
+			t.Errorf("Scan(%q) = %+v, %v, want %+v", src, n, err, valid)
+		}
+	}
+	n := valid
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Errorf("Scan(nil) = %+v, %v, want a null date", n, err)
+	}
+	if err := n.Scan(int64(20160101)); err == nil {
+		t.Error("Expected error scanning an int64")
+	}
+}
@@ -0,0 +1,265 @@
+package ethiopiancalendar
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrAmbiguousDate is returned by ParseFlexible when it cannot tell which
+// field of the input is the year.
+var ErrAmbiguousDate = errors.New("ambiguous date: write the year with four digits, first or last")
+
+// ParseFlexible parses a loosely formatted Ethiopian date such as
+// "1/1/2016", "01-01-2016", "2016-01-01", "Meskerem 1 2016" or
+// "1 መስከረም 2016". Fields may be separated by spaces, '/', '-', '.' or ','.
+//
+// Numbers may be written in ASCII digits or in Ge'ez numerals, as in
+// "፳፻፲፮-፩-፩". The year must be written with four digits, or in Ge'ez
+// numerals as a number of at least 100, so it can be told apart from the
+// day and month. Numeric dates whose first field is the year are read as
+// year, month, day; all other numeric dates are read as day, month, year. A month may instead be given by its English name (in any case) or
+// its Amharic name, in which case the remaining two fields are the day and
+// the four-digit year in either order. The result is validated.
+func ParseFlexible(s string) (EtDate, error) {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ' ' || r == '\t' || r == '/' || r == '-' || r == '.' || r == ','
+	})
+	if len(fields) != 3 {
+		return EtDate{}, fmt.Errorf("invalid date %q: expected day, month and year", s)
+	}
+
+	var d EtDate
+	if i := monthFieldIndex(fields); i >= 0 {
+		d.Month = monthByName(fields[i])
+		rest := append(fields[:i:i], fields[i+1:]...)
+		year, day := rest[0], rest[1]
+		if isYearField(day) == isYearField(year) {
+			return EtDate{}, fmt.Errorf("invalid date %q: %w", s, ErrAmbiguousDate)
+		}
+		if isYearField(day) {
+			year, day = day, year
+		}
+		var err error
+		if d.Year, err = parseNumeral(year); err != nil {
+			return EtDate{}, fmt.Errorf("invalid date %q: %w", s, err)
+		}
+		if d.Day, err = parseNumeral(day); err != nil {
+			return EtDate{}, fmt.Errorf("invalid date %q: %w", s, err)
+		}
+	} else {
+		var nums [3]int
+		for i, f := range fields {
+			n, err := parseNumeral(f)
+			if err != nil {
+				return EtDate{}, fmt.Errorf("invalid date %q: %w", s, err)
+			}
+			nums[i] = n
+		}
+		switch first, last := isYearField(fields[0]), isYearField(fields[2]); {
+		case first && !last:
+			d = EtDate{Year: nums[0], Month: nums[1], Day: nums[2]}
+		case last && !first:
+			d = EtDate{Year: nums[2], Month: nums[1], Day: nums[0]}
+		default:
+			return EtDate{}, fmt.Errorf("invalid date %q: %w", s, ErrAmbiguousDate)
+		}
+	}
+
+	if err := d.Validate(); err != nil {
+		return EtDate{}, err
+	}
+	return d, nil
+}
+
+// isYearField reports whether f is written like a year: four digits, or a
+// Ge'ez number of at least 100, which no day or month reaches.
+func isYearField(f string) bool {
+	if n, width := leadingGeez(f); width == len(f) {
+		return n >= 100
+	}
+	return utf8.RuneCountInString(f) == 4
+}
+
+// parseNumeral parses a field written entirely in ASCII digits or entirely
+// in Ge'ez numerals.
+func parseNumeral(f string) (int, error) {
+	if geezPrefixLen(f) > 0 {
+		return FromGeez(f)
+	}
+	return parseDigits(f)
+}
+
+// monthFieldIndex returns the index of the first field naming a month, or -1.
+func monthFieldIndex(fields []string) int {
+	for i, f := range fields {
+		if monthByName(f) != 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+// monthByName returns the month with the given English or Amharic name, or
+// 0 if none matches. English names match case-insensitively.
+func monthByName(name string) int {
+	for m := 1; m <= 13; m++ {
+		if strings.EqualFold(name, monthNames[m]) || name == amharicMonthNames[m] {
+			return m
+		}
+	}
+	return 0
+}
+
+// Parse is the inverse of Format: it reads value according to layout and
+// returns the validated date. Layouts use the same tokens and [literal]
+// brackets as Format, and Parse understands YYYY, MM, DD (two digits each
+// for MM and DD), M and D (one or two digits), Month (an English name in any
+// case, or an Amharic name) and Mon (an English abbreviation in any case).
+// The numeric tokens also accept Ge'ez numerals of any length, and GeezYYYY
+// accepts only those. Any other layout text must appear in value exactly.
+// For example, Parse("DD Month YYYY", "06 Tir 2017") returns Tir 6, 2017,
+// as does Parse("YYYY-MM-DD", "፳፻፲፯-፭-፮").
+//
+// The layout must contain a year, a month and a day.
+func Parse(layout, value string) (EtDate, error) {
+	var fields [3]int // year, month, day
+	var seen [3]bool
+	rest := value
+	for i := 0; i < len(layout); {
+		if layout[i] == '[' {
+			if end := strings.IndexByte(layout[i+1:], ']'); end >= 0 {
+				lit := layout[i+1 : i+1+end]
+				if !strings.HasPrefix(rest, lit) {
+					return EtDate{}, parseError(layout, value, rest, strconv.Quote(lit))
+				}
+				rest = rest[len(lit):]
+				i += end + 2
+				continue
+			}
+		}
+		token := layoutToken(layout[i:])
+		if token == "" {
+			if rest == "" || rest[0] != layout[i] {
+				return EtDate{}, parseError(layout, value, rest, strconv.Quote(layout[i:i+1]))
+			}
+			rest = rest[1:]
+			i++
+			continue
+		}
+		field, n, width := parseToken(token, rest)
+		if width == 0 {
+			if field < 0 {
+				return EtDate{}, fmt.Errorf("cannot parse %q as %q: Parse does not support the %s token", value, layout, token)
+			}
+			return EtDate{}, parseError(layout, value, rest, token)
+		}
+		fields[field], seen[field] = n, true
+		rest = rest[width:]
+		i += len(token)
+	}
+	if rest != "" {
+		return EtDate{}, fmt.Errorf("cannot parse %q as %q: extra text %q", value, layout, rest)
+	}
+	for f, name := range []string{"year", "month", "day"} {
+		if !seen[f] {
+			return EtDate{}, fmt.Errorf("cannot parse %q as %q: layout has no %s", value, layout, name)
+		}
+	}
+	d := EtDate{Year: fields[0], Month: fields[1], Day: fields[2]}
+	if err := d.Validate(); err != nil {
+		return EtDate{}, err
+	}
+	return d, nil
+}
+
+// parseError reports that the text rest of value does not start with what
+// layout expects there.
+func parseError(layout, value, rest, want string) error {
+	return fmt.Errorf("cannot parse %q as %q: expected %s at %q", value, layout, want, rest)
+}
+
+// parseToken reads token from the start of s. It returns the field the
+// token sets (0 year, 1 month, 2 day, or -1 for tokens Parse does not
+// support), the value, and the number of bytes read, which is 0 if s does
+// not match.
+func parseToken(token, s string) (field, n, width int) {
+	switch token {
+	case "YYYY":
+		n, width = leadingNumber(s, 4, 4)
+		return 0, n, width
+	case "GeezYYYY":
+		n, width = leadingGeez(s)
+		return 0, n, width
+	case "MM":
+		n, width = leadingNumber(s, 2, 2)
+		return 1, n, width
+	case "M":
+		n, width = leadingNumber(s, 1, 2)
+		return 1, n, width
+	case "DD":
+		n, width = leadingNumber(s, 2, 2)
+		return 2, n, width
+	case "D":
+		n, width = leadingNumber(s, 1, 2)
+		return 2, n, width
+	case "Month", "Mon":
+		// Take the longest matching name.
+		for m := 1; m <= 13; m++ {
+			var names []string
+			if token == "Month" {
+				names = []string{monthNames[m], amharicMonthNames[m]}
+			} else {
+				names = []string{shortMonthNames[m]}
+			}
+			for _, name := range names {
+				if len(name) > width && len(s) >= len(name) && strings.EqualFold(s[:len(name)], name) {
+					n, width = m, len(name)
+				}
+			}
+		}
+		return 1, n, width
+	}
+	return -1, 0, 0
+}
+
+// leadingNumber parses a number at the start of s, written either in Ge'ez
+// numerals, which are read whatever their length, or as ASCII digits, of
+// which there must be between minDigits and maxDigits. It returns a width of
+// 0 if s does not start with a valid number.
+func leadingNumber(s string, minDigits, maxDigits int) (n, width int) {
+	if n, width = leadingGeez(s); width > 0 {
+		return n, width
+	}
+	return leadingDigits(s, minDigits, maxDigits)
+}
+
+// leadingGeez parses the run of Ge'ez numerals at the start of s. It returns
+// a width of 0 if there is none or it is malformed.
+func leadingGeez(s string) (n, width int) {
+	width = geezPrefixLen(s)
+	if width == 0 {
+		return 0, 0
+	}
+	n, err := FromGeez(s[:width])
+	if err != nil {
+		return 0, 0
+	}
+	return n, width
+}
+
+// leadingDigits parses the longest run of ASCII digits at the start of s, of
+// at most maxDigits digits. It returns a width of 0 if the run is shorter
+// than minDigits.
+func leadingDigits(s string, minDigits, maxDigits int) (n, width int) {
+	for width < len(s) && width < maxDigits && '0' <= s[width] && s[width] <= '9' {
+		n = n*10 + int(s[width]-'0')
+		width++
+	}
+	if width < minDigits {
+		return 0, 0
+	}
+	return n, width
+}
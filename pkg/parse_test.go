@@ -0,0 +1,144 @@
+package ethiopiancalendar
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseFlexible(t *testing.T) {
+	tests := []struct {
+		in   string
+		want EtDate
+	}{
+		{"1/1/2016", EtDate{Year: 2016, Month: 1, Day: 1}},
+		{"01-01-2016", EtDate{Year: 2016, Month: 1, Day: 1}},
+		{"6.13.2015", EtDate{Year: 2015, Month: 13, Day: 6}},
+		{"12/3/2016", EtDate{Year: 2016, Month: 3, Day: 12}},
+		{"2016-01-02", EtDate{Year: 2016, Month: 1, Day: 2}},
+		{"2016/13/5", EtDate{Year: 2016, Month: 13, Day: 5}},
+		{"Meskerem 1 2016", EtDate{Year: 2016, Month: 1, Day: 1}},
+		{"meskerem 1, 2016", EtDate{Year: 2016, Month: 1, Day: 1}},
+		{"17 Meskerem 2016", EtDate{Year: 2016, Month: 1, Day: 17}},
+		{"2016 Tir 11", EtDate{Year: 2016, Month: 5, Day: 11}},
+		{"1 መስከረም 2016", EtDate{Year: 2016, Month: 1, Day: 1}},
+		{"  Pagume 6 2015 ", EtDate{Year: 2015, Month: 13, Day: 6}},
+		// Ge'ez numerals.
+		{"፳፻፲፮-፩-፩", EtDate{Year: 2016, Month: 1, Day: 1}},
+		{"፲፩/፭/፳፻፲፮", EtDate{Year: 2016, Month: 5, Day: 11}},
+		{"፩ መስከረም ፳፻፲፮", EtDate{Year: 2016, Month: 1, Day: 1}},
+		{"፳፻ Tir 11", EtDate{Year: 2000, Month: 5, Day: 11}},
+	}
+	for _, tt := range tests {
+		got, err := ParseFlexible(tt.in)
+		if err != nil {
+			t.Errorf("ParseFlexible(%q) returned error: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseFlexible(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseFlexibleInvalid(t *testing.T) {
+	ambiguous := []string{"1/1/16", "2016/1/2016", "Meskerem 1 16", "Meskerem 2016 2016"}
+	for _, s := range ambiguous {
+		if _, err := ParseFlexible(s); !errors.Is(err, ErrAmbiguousDate) {
+			t.Errorf("ParseFlexible(%q) error = %v, want ErrAmbiguousDate", s, err)
+		}
+	}
+
+	invalid := []string{"", "2016", "1/1", "1/1/2016/1", "Foo 1 2016", "1/x/2016", "+1/1/2016"}
+	for _, s := range invalid {
+		if d, err := ParseFlexible(s); err == nil {
+			t.Errorf("ParseFlexible(%q) = %+v, expected error", s, d)
+		}
+	}
+
+	if _, err := ParseFlexible("6/13/2016"); !errors.Is(err, ErrInvalidDay) {
+		t.Errorf("ParseFlexible(\"6/13/2016\") error = %v, want ErrInvalidDay", err)
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		layout, value string
+		want          EtDate
+	}{
+		{"DD Month YYYY", "06 Tir 2017", EtDate{Year: 2017, Month: 5, Day: 6}},
+		{"DD Month YYYY", "06 tir 2017", EtDate{Year: 2017, Month: 5, Day: 6}},
+		{"DD Month YYYY", "06 MESKEREM 2016", EtDate{Year: 2016, Month: 1, Day: 6}},
+		{"DD Month YYYY", "11 ጥር 2016", EtDate{Year: 2016, Month: 5, Day: 11}},
+		{"YYYY-MM-DD", "2015-13-06", EtDate{Year: 2015, Month: 13, Day: 6}},
+		{"D/M/YYYY", "5/1/2016", EtDate{Year: 2016, Month: 1, Day: 5}},
+		{"D/M/YYYY", "30/12/2016", EtDate{Year: 2016, Month: 12, Day: 30}},
+		{"Mon D, YYYY", "Meg 3, 2016", EtDate{Year: 2016, Month: 7, Day: 3}},
+		{"YYYYMMDD", "20160511", EtDate{Year: 2016, Month: 5, Day: 11}},
+		{"[Day] DD [of] Month YYYY", "Day 01 of Pagume 2015", EtDate{Year: 2015, Month: 13, Day: 1}},
+		{"DD Month GeezYYYY", "11 Tir ፳፻፲፮", EtDate{Year: 2016, Month: 5, Day: 11}},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.layout, tt.value)
+		if err != nil {
+			t.Errorf("Parse(%q, %q) returned error: %v", tt.layout, tt.value, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Parse(%q, %q) = %+v, want %+v", tt.layout, tt.value, got, tt.want)
+		}
+		// Parse reads back what Format writes.
+		if back, err := Parse(tt.layout, got.Format(tt.layout)); err != nil || back != got {
+			t.Errorf("Parse(%q, Format(%v)) = %+v, %v", tt.layout, got, back, err)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		layout, value, want string
+	}{
+		{"DD Month YYYY", "6 Tir 2017", `cannot parse "6 Tir 2017" as "DD Month YYYY": expected DD at "6 Tir 2017"`},
+		{"DD Month YYYY", "06 Tirr 2017", `cannot parse "06 Tirr 2017" as "DD Month YYYY": expected " " at "r 2017"`},
+		{"DD Month YYYY", "06 Foo 2017", `cannot parse "06 Foo 2017" as "DD Month YYYY": expected Month at "Foo 2017"`},
+		{"YYYY-MM-DD", "2016-01-01 extra", `cannot parse "2016-01-01 extra" as "YYYY-MM-DD": extra text " extra"`},
+		{"YYYY-MM-DD", "16-01-01", `cannot parse "16-01-01" as "YYYY-MM-DD": expected YYYY at "16-01-01"`},
+		{"Month YYYY", "Tir 2016", `cannot parse "Tir 2016" as "Month YYYY": layout has no day`},
+		{"Do Month YYYY", "1st Tir 2016", `cannot parse "1st Tir 2016" as "Do Month YYYY": Parse does not support the Do token`},
+	}
+	for _, tt := range tests {
+		if _, err := Parse(tt.layout, tt.value); err == nil || err.Error() != tt.want {
+			t.Errorf("Parse(%q, %q) error = %v, want %s", tt.layout, tt.value, err, tt.want)
+		}
+	}
+
+	if _, err := Parse("YYYY-MM-DD", "2016-13-06"); !errors.Is(err, ErrInvalidDay) {
+		t.Errorf("Parse of Pagume 6 in a common year error = %v, want ErrInvalidDay", err)
+	}
+}
+
+func TestParseGeez(t *testing.T) {
+	// A date written entirely in Ge'ez numerals, read in one call.
+	tests := []struct {
+		layout, value string
+	}{
+		{"YYYY-MM-DD", "፳፻፲፮-፩-፩"},
+		{"D/M/YYYY", "፩/፩/፳፻፲፮"},
+		{"D Month GeezYYYY", "፩ Meskerem ፳፻፲፮"},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.layout, tt.value)
+		if err != nil || got != (EtDate{Year: 2016, Month: 1, Day: 1}) {
+			t.Errorf("Parse(%q, %q) = %+v, %v, want 2016-01-01", tt.layout, tt.value, got, err)
+		}
+	}
+
+	for _, tt := range []struct{ layout, value string }{
+		{"YYYY-MM-DD", "፳፻፲፮-፩፩-፩"}, // malformed numeral
+		{"GeezYYYY-MM-DD", "2016-01-01"},
+		{"YYYY-MM-DD", "፳፻፲፮-፭-፴፩"}, // Tir 31
+	} {
+		if got, err := Parse(tt.layout, tt.value); err == nil {
+			t.Errorf("Parse(%q, %q) = %+v, expected error", tt.layout, tt.value, got)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package ethiopiancalendar
+
+import "math/rand/v2"
+
+// RandomDate returns a valid date chosen uniformly from the days of years
+// minYear through maxYear inclusive, so Pagume 6 only appears in leap years
+// and no month is over-represented. It panics if minYear is not positive or
+// maxYear is before minYear.
+func RandomDate(r *rand.Rand, minYear, maxYear int) EtDate {
+	if minYear <= 0 || maxYear < minYear {
+		panic("ethiopiancalendar: invalid year range for RandomDate")
+	}
+	start := yearStartJDN(minYear)
+	end := yearStartJDN(maxYear + 1)
+	return jdnToEt(start + r.IntN(end-start))
+}
@@ -0,0 +1,43 @@
+package ethiopiancalendar
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func TestRandomDate(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	pagume6 := 0
+	for i := 0; i < 10000; i++ {
+		d := RandomDate(r, 2011, 2016)
+		if err := d.Validate(); err != nil {
+			t.Fatalf("RandomDate returned invalid date %+v: %v", d, err)
+		}
+		if d.Year < 2011 || d.Year > 2016 {
+			t.Fatalf("RandomDate returned %+v outside 2011-2016", d)
+		}
+		if d.Month == 13 && d.Day == 6 {
+			pagume6++
+		}
+	}
+	if pagume6 == 0 {
+		t.Error("Expected Pagume 6 of 2011 or 2015 to be generated")
+	}
+}
+
+func TestRandomDateDeterministic(t *testing.T) {
+	a := RandomDate(rand.New(rand.NewPCG(7, 7)), 1, 9999)
+	b := RandomDate(rand.New(rand.NewPCG(7, 7)), 1, 9999)
+	if a != b {
+		t.Errorf("Expected equal seeds to give equal dates, got %+v and %+v", a, b)
+	}
+}
+
+func TestRandomDateInvalidRange(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for maxYear before minYear")
+		}
+	}()
+	RandomDate(rand.New(rand.NewPCG(1, 2)), 2016, 2015)
+}
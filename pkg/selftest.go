@@ -0,0 +1,66 @@
+package ethiopiancalendar
+
+import "fmt"
+
+// selfTestYears are the Ethiopian years SelfTest checks: the start of the
+// calendar, the years around the Gregorian 1900 non-leap century year and
+// the years around the present, including leap years.
+var selfTestYears = []int{1, 2, 3, 4, 1891, 1892, 1893, 2011, 2012, 2015, 2016, 2019, 2020}
+
+// SelfTest checks that the conversions agree with one another. For the
+// first and last day of every month of a representative set of years, it
+// round-trips the date through its Julian Day Number and its Gregorian date,
+// and checks that consecutive days have consecutive Julian Day Numbers. It
+// also checks that Meskerem 1, 2016 is 12 September 2023. It returns an
+// error describing the first inconsistency, or nil.
+//
+// SelfTest is meant for application startup checks.
+func SelfTest() error {
+	anchor := EtDate{Year: 2016, Month: 1, Day: 1}
+	if gy, gm, gd, err := anchor.ToGregorian(); err != nil || gy != 2023 || gm != 9 || gd != 12 {
+		return fmt.Errorf("self-test: %s converts to %04d-%02d-%02d (err %v), want 2023-09-12", anchor.CSV(), gy, gm, gd, err)
+	}
+	for _, year := range selfTestYears {
+		for month := 1; month <= 13; month++ {
+			for _, day := range []int{1, DaysInMonth(year, month)} {
+				if err := selfTestDate(EtDate{Year: year, Month: month, Day: day}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// selfTestDate runs the SelfTest checks on a single valid date.
+func selfTestDate(d EtDate) error {
+	jdn, err := d.ToJDN()
+	if err != nil {
+		return fmt.Errorf("self-test: %s to JDN: %w", d.CSV(), err)
+	}
+	if back, err := JDNToEt(jdn); err != nil || back != d {
+		return fmt.Errorf("self-test: %s has JDN %d, which converts back to %s (err %v)", d.CSV(), jdn, back.CSV(), err)
+	}
+	gy, gm, gd, err := d.ToGregorian()
+	if err != nil {
+		return fmt.Errorf("self-test: %s to Gregorian: %w", d.CSV(), err)
+	}
+	if back, err := FromGregorian(gy, gm, gd); err != nil || back != d {
+		return fmt.Errorf("self-test: %s is Gregorian %04d-%02d-%02d, which converts back to %s (err %v)", d.CSV(), gy, gm, gd, back.CSV(), err)
+	}
+	if gjdn, err := GregorianToJDN(gy, gm, gd); err != nil || gjdn != jdn {
+		return fmt.Errorf("self-test: %s has JDN %d but its Gregorian date has JDN %d (err %v)", d.CSV(), jdn, gjdn, err)
+	}
+	// Step to the next day by the calendar's rules rather than by JDN.
+	next := EtDate{Year: d.Year, Month: d.Month, Day: d.Day + 1}
+	if next.Day > DaysInMonth(d.Year, d.Month) {
+		next = EtDate{Year: d.Year, Month: d.Month + 1, Day: 1}
+		if next.Month > 13 {
+			next = EtDate{Year: d.Year + 1, Month: 1, Day: 1}
+		}
+	}
+	if njdn, err := next.ToJDN(); err != nil || njdn != jdn+1 {
+		return fmt.Errorf("self-test: %s has JDN %d but the next day, %s, has JDN %d (err %v)", d.CSV(), jdn, next.CSV(), njdn, err)
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+package ethiopiancalendar
+
+import "testing"
+
+func TestSelfTest(t *testing.T) {
+	if err := SelfTest(); err != nil {
+		t.Errorf("SelfTest() = %v", err)
+	}
+}
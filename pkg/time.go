@@ -0,0 +1,100 @@
+package ethiopiancalendar
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrSkippedDay is returned by ToTime for a date that a time zone skips
+// entirely, such as 30 December 2011 in Pacific/Apia.
+var ErrSkippedDay = errors.New("day does not exist in the time zone")
+
+// now is the clock used by Today; tests replace it.
+var now = time.Now
+
+// Today returns the Ethiopian date of the current wall-clock day in loc. The
+// date follows the calendar day in loc, not in UTC, so for Africa/Addis_Ababa
+// (UTC+3) it changes at local midnight. A nil loc means time.Local.
+func Today(loc *time.Location) EtDate {
+	if loc == nil {
+		loc = time.Local
+	}
+	t := now().In(loc)
+	// Every date since the Ethiopian epoch converts, so this cannot fail.
+	d, _ := FromGregorian(t.Year(), int(t.Month()), t.Day())
+	return d
+}
+
+// Now returns today's Ethiopian date in the process's local time zone,
+// time.Local. Use Today with Africa/Addis_Ababa for the date in Ethiopia
+// when the process may run elsewhere.
+func Now() EtDate {
+	return Today(time.Local)
+}
+
+// EthiopianDateGregorianDaysFromNow returns the Ethiopian date n days after
+// today's Gregorian date in loc (before it for negative n), for reminders
+// scheduled on the Gregorian clock. A nil loc means time.Local.
+func EthiopianDateGregorianDaysFromNow(n int, loc *time.Location) (EtDate, error) {
+	if loc == nil {
+		loc = time.Local
+	}
+	t := now().In(loc)
+	// Count in UTC dates so daylight-saving changes in loc cannot shift a day.
+	return FromTime(time.Date(t.Year(), t.Month(), t.Day()+n, 0, 0, 0, 0, time.UTC))
+}
+
+// ToTime returns the first instant of d in loc as a time.Time, which is
+// midnight unless a daylight-saving transition skips it (as in
+// America/Sao_Paulo until 2019), in which case it is the end of the gap. A
+// nil loc means time.Local. It returns an error wrapping ErrSkippedDay if loc
+// skips the whole day, as Pacific/Apia did when it crossed the date line.
+//
+// For every valid d and every loc in which d exists, FromTime(d.ToTime(loc))
+// returns d.
+func (d EtDate) ToTime(loc *time.Location) (time.Time, error) {
+	gy, gm, gd, err := d.ToGregorian()
+	if err != nil {
+		return time.Time{}, err
+	}
+	if loc == nil {
+		loc = time.Local
+	}
+	want := time.Date(gy, time.Month(gm), gd, 0, 0, 0, 0, time.UTC)
+	civil := func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	t := time.Date(gy, time.Month(gm), gd, 0, 0, 0, 0, loc)
+	// time.Date resolves a skipped midnight to the previous evening, so step
+	// forward to the end of the gap, which is at most a day away.
+	for i := 0; i < 24 && civil(t).Before(want); i++ {
+		t = t.Add(time.Hour)
+	}
+	if !civil(t).Equal(want) {
+		return time.Time{}, fmt.Errorf("%04d-%02d-%02d in %s: %w", gy, gm, gd, loc, ErrSkippedDay)
+	}
+	return t, nil
+}
+
+// GregorianTime returns the Gregorian date of d as a time.Time in loc. It is
+// the same as ToTime: midnight, or the end of the gap where a
+// daylight-saving transition skips midnight.
+func (d EtDate) GregorianTime(loc *time.Location) (time.Time, error) {
+	return d.ToTime(loc)
+}
+
+// FromTime returns the Ethiopian date of the calendar day t falls on in its
+// own location. The time of day is discarded. Convert t with In first to
+// get the date in another zone.
+func FromTime(t time.Time) (EtDate, error) {
+	return FromGregorian(t.Year(), int(t.Month()), t.Day())
+}
+
+// DaysUntilNewYearFromNow returns the number of days from Today(time.Local)
+// to the next Meskerem 1, or 0 on New Year's Day itself.
+func DaysUntilNewYearFromNow() int {
+	// Today always returns a valid date, so this cannot fail.
+	days, _ := Today(time.Local).DaysUntilNewYear()
+	return days
+}
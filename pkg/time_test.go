@@ -0,0 +1,169 @@
+package ethiopiancalendar
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// setNow fixes the package clock at t for the duration of the test.
+func setNow(t *testing.T, at time.Time) {
+	t.Helper()
+	now = func() time.Time { return at }
+	t.Cleanup(func() { now = time.Now })
+}
+
+func TestTodayAcrossMidnight(t *testing.T) {
+	// 21:30 UTC on 11 September 2023 is 00:30 EAT on 12 September, which is
+	// Meskerem 1, 2016 in Addis Ababa but still Pagume 6, 2015 in UTC.
+	setNow(t, time.Date(2023, 9, 11, 21, 30, 0, 0, time.UTC))
+	eat := time.FixedZone("EAT", 3*60*60)
+
+	if got := Today(eat); got != (EtDate{Year: 2016, Month: 1, Day: 1}) {
+		t.Errorf("Today(EAT) = %+v, want 2016-01-01", got)
+	}
+	if got := Today(time.UTC); got != (EtDate{Year: 2015, Month: 13, Day: 6}) {
+		t.Errorf("Today(UTC) = %+v, want 2015-13-06", got)
+	}
+}
+
+func TestNow(t *testing.T) {
+	// Noon local time on 12 September 2023 is Meskerem 1, 2016 in any zone's
+	// own wall-clock reading.
+	setNow(t, time.Date(2023, 9, 12, 12, 0, 0, 0, time.Local))
+	if got := Now(); got != (EtDate{Year: 2016, Month: 1, Day: 1}) {
+		t.Errorf("Now() = %+v, want 2016-01-01", got)
+	}
+	if got, want := Now(), Today(nil); got != want {
+		t.Errorf("Now() = %+v, but Today(nil) = %+v", got, want)
+	}
+}
+
+func TestDaysUntilNewYearFromNow(t *testing.T) {
+	// Noon on 9 September 2023 is Pagume 4, 2015, three days before
+	// Meskerem 1 in the leap year's six-day Pagume.
+	setNow(t, time.Date(2023, 9, 9, 12, 0, 0, 0, time.Local))
+	if got := DaysUntilNewYearFromNow(); got != 3 {
+		t.Errorf("DaysUntilNewYearFromNow() = %d, want 3", got)
+	}
+}
+
+func TestTimeRoundTripLeapYear(t *testing.T) {
+	eat := time.FixedZone("EAT", 3*60*60)
+	// 2015 is a leap year, so the walk ends on Pagume 6.
+	d := EtDate{Year: 2015, Month: 1, Day: 1}
+	want, _ := time.Parse(time.DateOnly, "2022-09-11")
+	for i := range 366 {
+		tm, err := d.ToTime(eat)
+		if err != nil {
+			t.Fatalf("ToTime(%v) error: %v", d, err)
+		}
+		if y, m, day := tm.Date(); y != want.Year() || m != want.Month() || day != want.Day() || tm.Hour() != 0 || tm.Location() != eat {
+			t.Fatalf("ToTime(%v) = %v, want midnight EAT on %s", d, tm, want.Format(time.DateOnly))
+		}
+		got, err := FromTime(tm)
+		if err != nil || got != d {
+			t.Fatalf("FromTime(ToTime(%v)) = %v, %v", d, got, err)
+		}
+		if i < 365 {
+			d, _ = d.AddDays(1)
+			want = want.AddDate(0, 0, 1)
+		}
+	}
+	if d != (EtDate{Year: 2015, Month: 13, Day: 6}) {
+		t.Errorf("Walk ended on %v, want Pagume 6, 2015", d)
+	}
+}
+
+func TestToTimeSkippedMidnight(t *testing.T) {
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Skip("tz database unavailable:", err)
+	}
+	// Clocks jumped from 00:00 to 01:00 on 4 November 2018 (Tikimt 25, 2011).
+	d := EtDate{Year: 2011, Month: 2, Day: 25}
+	tm, err := d.ToTime(loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2018, 11, 4, 1, 0, 0, 0, loc); !tm.Equal(want) {
+		t.Errorf("ToTime(%v) = %v, want %v", d, tm, want)
+	}
+	if got, _ := FromTime(tm); got != d {
+		t.Errorf("FromTime(ToTime(%v)) = %v", d, got)
+	}
+}
+
+func TestToTimeSkippedDay(t *testing.T) {
+	loc, err := time.LoadLocation("Pacific/Apia")
+	if err != nil {
+		t.Skip("tz database unavailable:", err)
+	}
+	// Samoa skipped 30 December 2011 (Tahsas 20, 2004) when it moved across
+	// the date line.
+	skipped := EtDate{Year: 2004, Month: 4, Day: 20}
+	if _, err := skipped.ToTime(loc); !errors.Is(err, ErrSkippedDay) {
+		t.Errorf("ToTime(%v) error = %v, want ErrSkippedDay", skipped, err)
+	}
+	for _, d := range []EtDate{{Year: 2004, Month: 4, Day: 19}, {Year: 2004, Month: 4, Day: 21}} {
+		tm, err := d.ToTime(loc)
+		if err != nil {
+			t.Fatalf("ToTime(%v) error: %v", d, err)
+		}
+		if got, _ := FromTime(tm); got != d {
+			t.Errorf("FromTime(ToTime(%v)) = %v", d, got)
+		}
+	}
+}
+
+func TestFromTimeUsesOwnLocation(t *testing.T) {
+	// 22:00 UTC on 11 September 2023 is already 12 September in EAT.
+	tm := time.Date(2023, 9, 11, 22, 0, 0, 0, time.UTC)
+	if got, _ := FromTime(tm); got != (EtDate{Year: 2015, Month: 13, Day: 6}) {
+		t.Errorf("FromTime(UTC) = %v, want 2015-13-06", got)
+	}
+	if got, _ := FromTime(tm.In(time.FixedZone("EAT", 3*60*60))); got != (EtDate{Year: 2016, Month: 1, Day: 1}) {
+		t.Errorf("FromTime(EAT) = %v, want 2016-01-01", got)
+	}
+	if _, err := (EtDate{Year: 2016, Month: 13, Day: 6}).ToTime(time.UTC); err == nil {
+		t.Error("Expected error from ToTime for an invalid date")
+	}
+}
+
+func TestEthiopianDateGregorianDaysFromNow(t *testing.T) {
+	// 22:00 UTC on 10 September 2023 is already 11 September in EAT.
+	setNow(t, time.Date(2023, 9, 10, 22, 0, 0, 0, time.UTC))
+	eat := time.FixedZone("EAT", 3*60*60)
+
+	tests := []struct {
+		n    int
+		loc  *time.Location
+		want EtDate
+	}{
+		{0, eat, EtDate{Year: 2015, Month: 13, Day: 6}},
+		{1, eat, EtDate{Year: 2016, Month: 1, Day: 1}},
+		{1, time.UTC, EtDate{Year: 2015, Month: 13, Day: 6}},
+		{-5, eat, EtDate{Year: 2015, Month: 13, Day: 1}},
+		{366, eat, EtDate{Year: 2017, Month: 1, Day: 1}}, // 11 September 2024
+	}
+	for _, tt := range tests {
+		got, err := EthiopianDateGregorianDaysFromNow(tt.n, tt.loc)
+		if err != nil || got != tt.want {
+			t.Errorf("EthiopianDateGregorianDaysFromNow(%d, %v) = %v, %v, want %v", tt.n, tt.loc, got, err, tt.want)
+		}
+	}
+}
+
+func TestGregorianTime(t *testing.T) {
+	eat := time.FixedZone("EAT", 3*60*60)
+	tm, err := EtDate{Year: 2016, Month: 1, Day: 1}.GregorianTime(eat)
+	if err != nil {
+		t.Fatalf("GregorianTime error: %v", err)
+	}
+	if tm.Year() != 2023 || tm.Month() != time.September || tm.Day() != 12 || tm.Hour() != 0 || tm.Location() != eat {
+		t.Errorf("GregorianTime = %v, want 2023-09-12 00:00 EAT", tm)
+	}
+	if _, err := (EtDate{Year: 0, Month: 1, Day: 1}).GregorianTime(eat); err == nil {
+		t.Error("GregorianTime of year 0 succeeded, want an error")
+	}
+}
@@ -0,0 +1,298 @@
+package ethiopiancalendar
+
+import "time"
+
+// weekdayOfJDN returns the day of the week of a Julian Day Number. JDN 0 was
+// a Monday; Ethiopian and Gregorian dates with the same JDN share a weekday.
+func weekdayOfJDN(jdn int) time.Weekday {
+	return time.Weekday((jdn%7 + 8) % 7)
+}
+
+// amharicWeekdayNames holds the Amharic weekday names indexed by
+// time.Weekday, from እሑድ (Sunday) to ቅዳሜ (Saturday).
+var amharicWeekdayNames = [7]string{"እሑድ", "ሰኞ", "ማክሰኞ", "ረቡዕ", "ሐሙስ", "ዓርብ", "ቅዳሜ"}
+
+// WeekdayNames holds the transliterated Amharic weekday names indexed by the
+// result of Weekday, from Ehud (Sunday) to Kidame (Saturday).
+var WeekdayNames = []string{"Ehud", "Segno", "Maksegno", "Rob", "Hamus", "Arb", "Kidame"}
+
+// GregorianWeekdayNameAmharic returns the Amharic name of the weekday t falls
+// on in its own location.
+func GregorianWeekdayNameAmharic(t time.Time) string {
+	return amharicWeekdayNames[t.Weekday()]
+}
+
+// WeekdayNameLocale returns the name of the weekday d falls on in the given
+// locale: LocaleAmharic gives names such as ረቡዕ, and LocaleEnglish, like
+// unknown locales, gives names such as Wednesday.
+func (d EtDate) WeekdayNameLocale(locale string) (string, error) {
+	jdn, err := d.ToJDN()
+	if err != nil {
+		return "", err
+	}
+	wd := weekdayOfJDN(jdn)
+	if locale == LocaleAmharic {
+		return amharicWeekdayNames[wd], nil
+	}
+	return wd.String(), nil
+}
+
+// WeeksInYear returns the number of calendar weeks the Ethiopian year spans
+// when weeks begin on startOfWeek. Every week containing at least one day of
+// the year is counted, so a partial first week and a partial last week (the
+// final Pagume days often form one) each count as a whole week. The result
+// is 53 or 54 for valid years and 0 if year is not positive.
+func WeeksInYear(year int, startOfWeek time.Weekday) int {
+	if year <= 0 {
+		return 0
+	}
+	lead := (int(weekdayOfJDN(yearStartJDN(year))) - int(startOfWeek) + 7) % 7
+	return (lead + daysInYear(year) + 6) / 7
+}
+
+// weekStartOrDefault returns the per-call week start override passed to a
+// week helper, or DefaultCalendar.WeekStart when none was given.
+func weekStartOrDefault(startOfWeek []time.Weekday) time.Weekday {
+	if len(startOfWeek) > 0 {
+		return startOfWeek[0]
+	}
+	return DefaultCalendar.WeekStart
+}
+
+// WeekOfYear returns the number of the calendar week containing d, from 1 for
+// the week containing Meskerem 1 to WeeksInYear for the week containing the
+// last day of Pagume. Weeks begin on startOfWeek if it is given and on
+// DefaultCalendar.WeekStart otherwise.
+func (d EtDate) WeekOfYear(startOfWeek ...time.Weekday) (int, error) {
+	jdn, err := d.ToJDN()
+	if err != nil {
+		return 0, err
+	}
+	first := weekStartJDN(yearStartJDN(d.Year), weekStartOrDefault(startOfWeek))
+	return (jdn-first)/7 + 1, nil
+}
+
+// FirstWeekdayOfMonth returns the column, from 0 to 6, in which day 1 of the
+// given Ethiopian month falls when weeks begin on startOfWeek, or on
+// DefaultCalendar.WeekStart if it is not given. With weeks starting on Ehud
+// (Sunday) the column is the weekday of day 1.
+func FirstWeekdayOfMonth(year, month int, startOfWeek ...time.Weekday) (int, error) {
+	first, err := EtDate{Year: year, Month: month, Day: 1}.ToJDN()
+	if err != nil {
+		return 0, err
+	}
+	return first - weekStartJDN(first, weekStartOrDefault(startOfWeek)), nil
+}
+
+// WeekdayCount returns how many times each weekday occurs in the given
+// Ethiopian month. Every weekday has an entry, which is 0 for weekdays a
+// five- or six-day Pagume does not reach.
+func WeekdayCount(year, month int) (map[time.Weekday]int, error) {
+	first, err := EtDate{Year: year, Month: month, Day: 1}.ToJDN()
+	if err != nil {
+		return nil, err
+	}
+	days := DaysInMonth(year, month)
+	counts := make(map[time.Weekday]int, 7)
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		counts[wd] = days / 7
+	}
+	for i := range days % 7 {
+		counts[weekdayOfJDN(first+i)]++
+	}
+	return counts, nil
+}
+
+// WeekdayDatesInMonth returns every date in the given Ethiopian month that
+// falls on weekday, in order. The result is empty, not an error, when a short
+// Pagume does not reach weekday.
+func WeekdayDatesInMonth(year, month int, weekday time.Weekday) ([]EtDate, error) {
+	// In a week starting on weekday, day 1 falls in column col, so the first
+	// weekday of the month is the first day of the following week unless col
+	// is 0.
+	col, err := FirstWeekdayOfMonth(year, month, weekday)
+	if err != nil {
+		return nil, err
+	}
+	var dates []EtDate
+	for day := 1 + (7-col)%7; day <= DaysInMonth(year, month); day += 7 {
+		dates = append(dates, EtDate{Year: year, Month: month, Day: day})
+	}
+	return dates, nil
+}
+
+// Weekday returns the day of the week of d as an index into WeekdayNames,
+// from 0 for Ehud (Sunday) to 6 for Kidame (Saturday), the same numbering as
+// time.Weekday. It is derived from the Julian Day Number, so the epoch,
+// Meskerem 1 of year 1, is 3 (Rob, Wednesday). For a week that starts on
+// Segno, use (wd+6)%7 as the column.
+func (d EtDate) Weekday() (int, error) {
+	jdn, err := d.ToJDN()
+	if err != nil {
+		return 0, err
+	}
+	return int(weekdayOfJDN(jdn)), nil
+}
+
+// GregorianWeekday returns the weekday of the Gregorian date equivalent to
+// d. Both calendars count the same continuous week, so this is also the
+// Ethiopian weekday of d.
+func (d EtDate) GregorianWeekday() (time.Weekday, error) {
+	gy, gm, gd, err := d.ToGregorian()
+	if err != nil {
+		return 0, err
+	}
+	return time.Date(gy, time.Month(gm), gd, 0, 0, 0, 0, time.UTC).Weekday(), nil
+}
+
+// IsSameWeek reports whether d and other fall in the same week when weeks
+// begin on startOfWeek. Weeks run continuously across month and year ends,
+// including through Pagume.
+func (d EtDate) IsSameWeek(other EtDate, startOfWeek time.Weekday) (bool, error) {
+	a, err := d.ToJDN()
+	if err != nil {
+		return false, err
+	}
+	b, err := other.ToJDN()
+	if err != nil {
+		return false, err
+	}
+	return weekStartJDN(a, startOfWeek) == weekStartJDN(b, startOfWeek), nil
+}
+
+// StartOfWeek returns the first day of the week containing d when weeks
+// begin on startOfWeek: d itself if it falls on startOfWeek, and otherwise
+// the latest such day before it, which may be in the previous month or year.
+func (d EtDate) StartOfWeek(startOfWeek time.Weekday) (EtDate, error) {
+	jdn, err := d.ToJDN()
+	if err != nil {
+		return EtDate{}, err
+	}
+	return JDNToEt(weekStartJDN(jdn, startOfWeek))
+}
+
+// GregorianRangeOfEthiopianWeek returns the first and last Gregorian dates
+// of the week containing d when weeks begin on startOfWeek, for laying out
+// Ethiopian and Gregorian weeks side by side. The span may cross a Gregorian
+// month or year end.
+func GregorianRangeOfEthiopianWeek(d EtDate, startOfWeek time.Weekday) (start, end GregorianDate, err error) {
+	jdn, err := d.ToJDN()
+	if err != nil {
+		return GregorianDate{}, GregorianDate{}, err
+	}
+	first := weekStartJDN(jdn, startOfWeek)
+	if start.Year, start.Month, start.Day, err = JDNToGregorian(first); err != nil {
+		return GregorianDate{}, GregorianDate{}, err
+	}
+	if end.Year, end.Month, end.Day, err = JDNToGregorian(first + 6); err != nil {
+		return GregorianDate{}, GregorianDate{}, err
+	}
+	return start, end, nil
+}
+
+// weekStartJDN returns the JDN of the first day of the week containing jdn.
+func weekStartJDN(jdn int, startOfWeek time.Weekday) int {
+	return jdn - (int(weekdayOfJDN(jdn))-int(startOfWeek)+7)%7
+}
+
+// CountWeekday returns how many days from start to end inclusive fall on
+// weekday. It computes the count from the span's length rather than visiting
+// each day. It returns ErrInvalidRange if end is before start.
+func CountWeekday(start, end EtDate, weekday time.Weekday) (int, error) {
+	first, err := start.ToJDN()
+	if err != nil {
+		return 0, err
+	}
+	last, err := end.ToJDN()
+	if err != nil {
+		return 0, err
+	}
+	if last < first {
+		return 0, ErrInvalidRange
+	}
+	days := last - first + 1
+	count := days / 7
+	if daysUntilWeekday(first, weekday) < days%7 {
+		count++
+	}
+	return count, nil
+}
+
+// daysUntilWeekday returns how many days after the day with Julian Day
+// Number jdn the next weekday on or after it falls, from 0 to 6.
+func daysUntilWeekday(jdn int, weekday time.Weekday) int {
+	return (int(weekday) - int(weekdayOfJDN(jdn)) + 7) % 7
+}
+
+// IsWeekend reports whether the date falls on a Saturday (Kidame) or a
+// Sunday (Ehud).
+func (d EtDate) IsWeekend() (bool, error) {
+	jdn, err := d.ToJDN()
+	if err != nil {
+		return false, err
+	}
+	wd := weekdayOfJDN(jdn)
+	return wd == time.Saturday || wd == time.Sunday, nil
+}
+
+// NextWeekday returns d if it falls on a weekday, otherwise the first
+// weekday after it.
+func (d EtDate) NextWeekday() (EtDate, error) {
+	return d.nearestWeekday(1)
+}
+
+// PreviousWeekday returns d if it falls on a weekday, otherwise the last
+// weekday before it.
+func (d EtDate) PreviousWeekday() (EtDate, error) {
+	return d.nearestWeekday(-1)
+}
+
+// nearestWeekday steps from d by step days until it reaches a weekday.
+func (d EtDate) nearestWeekday(step int) (EtDate, error) {
+	for {
+		weekend, err := d.IsWeekend()
+		if err != nil {
+			return EtDate{}, err
+		}
+		if !weekend {
+			return d, nil
+		}
+		if d, err = d.AddDays(step); err != nil {
+			return EtDate{}, err
+		}
+	}
+}
+
+// Next returns the first date strictly after d that falls on weekday. If d
+// is itself on weekday, that is the date a week later.
+func (d EtDate) Next(weekday time.Weekday) (EtDate, error) {
+	jdn, err := d.ToJDN()
+	if err != nil {
+		return EtDate{}, err
+	}
+	days := (int(weekday)-int(weekdayOfJDN(jdn))+6)%7 + 1
+	return d.AddDays(days)
+}
+
+// Previous returns the last date strictly before d that falls on weekday. If
+// d is itself on weekday, that is the date a week earlier.
+func (d EtDate) Previous(weekday time.Weekday) (EtDate, error) {
+	jdn, err := d.ToJDN()
+	if err != nil {
+		return EtDate{}, err
+	}
+	days := (int(weekdayOfJDN(jdn))-int(weekday)+6)%7 + 1
+	return d.AddDays(-days)
+}
+
+// AddWeeksInterval returns the date periods repetitions of a weeks-long
+// interval after d, for recurring schedules such as "every 3 weeks".
+// Negative periods move backwards.
+func (d EtDate) AddWeeksInterval(weeks, periods int) (EtDate, error) {
+	return d.AddDays(7 * weeks * periods)
+}
+
+// AddBiweekly returns the date periods fortnights after d.
+func (d EtDate) AddBiweekly(periods int) (EtDate, error) {
+	return d.AddWeeksInterval(2, periods)
+}
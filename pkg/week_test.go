@@ -0,0 +1,540 @@
+package ethiopiancalendar
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestWeekdayOfJDN(t *testing.T) {
+	tests := []struct {
+		jdn  int
+		want time.Weekday
+	}{
+		{0, time.Monday},
+		{2460200, time.Tuesday}, // 12 September 2023, Meskerem 1 2016
+		{2459834, time.Sunday},  // 11 September 2022, Meskerem 1 2015
+	}
+	for _, tt := range tests {
+		if got := weekdayOfJDN(tt.jdn); got != tt.want {
+			t.Errorf("weekdayOfJDN(%d) = %v, want %v", tt.jdn, got, tt.want)
+		}
+	}
+}
+
+func TestWeeksInYear(t *testing.T) {
+	tests := []struct {
+		year        int
+		startOfWeek time.Weekday
+		want        int
+	}{
+		// 2015 is a leap year starting on a Sunday.
+		{2015, time.Sunday, 53},
+		{2015, time.Monday, 54},
+		// 2016 is a common year starting on a Tuesday.
+		{2016, time.Sunday, 53},
+		{2016, time.Monday, 53},
+		{2016, time.Tuesday, 53},
+		{0, time.Sunday, 0},
+	}
+	for _, tt := range tests {
+		if got := WeeksInYear(tt.year, tt.startOfWeek); got != tt.want {
+			t.Errorf("WeeksInYear(%d, %v) = %d, want %d", tt.year, tt.startOfWeek, got, tt.want)
+		}
+	}
+}
+
+func TestSnapToWeekday(t *testing.T) {
+	friday := EtDate{Year: 2016, Month: 1, Day: 4}
+	saturday := EtDate{Year: 2016, Month: 1, Day: 5}
+	sunday := EtDate{Year: 2016, Month: 1, Day: 6}
+	monday := EtDate{Year: 2016, Month: 1, Day: 7}
+
+	tests := []struct {
+		date     EtDate
+		next     EtDate
+		previous EtDate
+	}{
+		{saturday, monday, friday},
+		{sunday, monday, friday},
+		{friday, friday, friday},
+		{monday, monday, monday},
+	}
+	for _, tt := range tests {
+		next, err := tt.date.NextWeekday()
+		if err != nil || next != tt.next {
+			t.Errorf("%+v.NextWeekday() = %+v, %v, want %+v", tt.date, next, err, tt.next)
+		}
+		previous, err := tt.date.PreviousWeekday()
+		if err != nil || previous != tt.previous {
+			t.Errorf("%+v.PreviousWeekday() = %+v, %v, want %+v", tt.date, previous, err, tt.previous)
+		}
+	}
+
+	for date, want := range map[EtDate]bool{saturday: true, sunday: true, friday: false, monday: false} {
+		if got, err := date.IsWeekend(); err != nil || got != want {
+			t.Errorf("%+v.IsWeekend() = %v, %v, want %v", date, got, err, want)
+		}
+	}
+
+	if _, err := (EtDate{Year: 2016, Month: 13, Day: 6}).NextWeekday(); err == nil {
+		t.Error("Expected error for invalid date")
+	}
+}
+
+func TestAddWeeksInterval(t *testing.T) {
+	start := EtDate{Year: 2015, Month: 12, Day: 20}
+	tests := []struct {
+		weeks, periods int
+		want           EtDate
+	}{
+		{2, 0, start},
+		{2, 1, EtDate{Year: 2015, Month: 13, Day: 4}},
+		// 2015 is a leap year, so Pagume has 6 days before the new year.
+		{2, 2, EtDate{Year: 2016, Month: 1, Day: 12}},
+		{2, 3, EtDate{Year: 2016, Month: 1, Day: 26}},
+		{3, 1, EtDate{Year: 2016, Month: 1, Day: 5}},
+		{2, -1, EtDate{Year: 2015, Month: 12, Day: 6}},
+	}
+	for _, tt := range tests {
+		got, err := start.AddWeeksInterval(tt.weeks, tt.periods)
+		if err != nil || got != tt.want {
+			t.Errorf("AddWeeksInterval(%d, %d) = %+v, %v, want %+v", tt.weeks, tt.periods, got, err, tt.want)
+		}
+	}
+
+	// 2016 is a common year with a 5-day Pagume.
+	got, err := (EtDate{Year: 2016, Month: 13, Day: 1}).AddBiweekly(1)
+	if err != nil || got != (EtDate{Year: 2017, Month: 1, Day: 10}) {
+		t.Errorf("AddBiweekly(1) = %+v, %v, want 2017-01-10", got, err)
+	}
+}
+
+func TestWeekdayCount(t *testing.T) {
+	tests := []struct {
+		year, month int
+		want        map[time.Weekday]int
+	}{
+		// Meskerem 2016 starts on a Tuesday.
+		{2016, 1, map[time.Weekday]int{
+			time.Sunday: 4, time.Monday: 4, time.Tuesday: 5, time.Wednesday: 5,
+			time.Thursday: 4, time.Friday: 4, time.Saturday: 4,
+		}},
+		// Pagume 2015 has six days starting on a Wednesday.
+		{2015, 13, map[time.Weekday]int{
+			time.Sunday: 1, time.Monday: 1, time.Tuesday: 0, time.Wednesday: 1,
+			time.Thursday: 1, time.Friday: 1, time.Saturday: 1,
+		}},
+	}
+	for _, tt := range tests {
+		got, err := WeekdayCount(tt.year, tt.month)
+		if err != nil {
+			t.Fatalf("WeekdayCount(%d, %d) error: %v", tt.year, tt.month, err)
+		}
+		if !maps.Equal(got, tt.want) {
+			t.Errorf("WeekdayCount(%d, %d) = %v, want %v", tt.year, tt.month, got, tt.want)
+		}
+	}
+
+	for year := 2010; year <= 2017; year++ {
+		for month := 1; month <= 13; month++ {
+			counts, err := WeekdayCount(year, month)
+			if err != nil {
+				t.Fatalf("WeekdayCount(%d, %d) error: %v", year, month, err)
+			}
+			sum := 0
+			for _, n := range counts {
+				sum += n
+			}
+			if sum != DaysInMonth(year, month) || len(counts) != 7 {
+				t.Errorf("WeekdayCount(%d, %d) sums to %d over %d weekdays, want %d over 7", year, month, sum, len(counts), DaysInMonth(year, month))
+			}
+		}
+	}
+
+	if _, err := WeekdayCount(2016, 14); err == nil {
+		t.Error("Expected error for month 14")
+	}
+}
+
+func TestGregorianWeekday(t *testing.T) {
+	// Meskerem 1, 2016 is Tuesday, 12 September 2023.
+	if got, err := (EtDate{Year: 2016, Month: 1, Day: 1}).GregorianWeekday(); err != nil || got != time.Tuesday {
+		t.Errorf("GregorianWeekday(2016-01-01) = %v, %v, want Tuesday", got, err)
+	}
+
+	d := EtDate{Year: 2015, Month: 1, Day: 1}
+	for range 800 {
+		got, err := d.GregorianWeekday()
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := d.Weekday()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if int(got) != want {
+			t.Fatalf("GregorianWeekday(%v) = %v, but Weekday() = %d", d, got, want)
+		}
+		d, _ = d.AddDays(1)
+	}
+
+	if _, err := (EtDate{Year: 2016, Month: 13, Day: 6}).GregorianWeekday(); err == nil {
+		t.Error("Expected error for an invalid date")
+	}
+}
+
+func TestIsSameWeek(t *testing.T) {
+	tests := []struct {
+		a, b  EtDate
+		start time.Weekday
+		want  bool
+	}{
+		{EtDate{Year: 2016, Month: 1, Day: 1}, EtDate{Year: 2016, Month: 1, Day: 1}, time.Sunday, true},
+		// Meskerem 30, 2016 is a Wednesday; Tikimt 1 is the Thursday after.
+		{EtDate{Year: 2016, Month: 1, Day: 30}, EtDate{Year: 2016, Month: 2, Day: 1}, time.Sunday, true},
+		{EtDate{Year: 2016, Month: 1, Day: 30}, EtDate{Year: 2016, Month: 2, Day: 3}, time.Sunday, true},
+		{EtDate{Year: 2016, Month: 1, Day: 30}, EtDate{Year: 2016, Month: 2, Day: 4}, time.Sunday, false},
+		{EtDate{Year: 2016, Month: 1, Day: 30}, EtDate{Year: 2016, Month: 2, Day: 4}, time.Monday, true},
+		{EtDate{Year: 2016, Month: 1, Day: 30}, EtDate{Year: 2016, Month: 2, Day: 5}, time.Monday, false},
+		{EtDate{Year: 2016, Month: 1, Day: 26}, EtDate{Year: 2016, Month: 1, Day: 27}, time.Sunday, false},
+		// Pagume 6, 2015 (Monday) and Meskerem 1, 2016 (Tuesday).
+		{EtDate{Year: 2015, Month: 13, Day: 6}, EtDate{Year: 2016, Month: 1, Day: 1}, time.Sunday, true},
+		{EtDate{Year: 2015, Month: 13, Day: 6}, EtDate{Year: 2016, Month: 1, Day: 1}, time.Tuesday, false},
+		{EtDate{Year: 2015, Month: 13, Day: 1}, EtDate{Year: 2015, Month: 13, Day: 6}, time.Sunday, false},
+		{EtDate{Year: 2016, Month: 1, Day: 1}, EtDate{Year: 2017, Month: 1, Day: 1}, time.Sunday, false},
+	}
+	for _, tt := range tests {
+		got, err := tt.a.IsSameWeek(tt.b, tt.start)
+		if err != nil {
+			t.Fatalf("IsSameWeek(%v, %v, %v) error: %v", tt.a, tt.b, tt.start, err)
+		}
+		if got != tt.want {
+			t.Errorf("IsSameWeek(%v, %v, %v) = %v, want %v", tt.a, tt.b, tt.start, got, tt.want)
+		}
+		if back, _ := tt.b.IsSameWeek(tt.a, tt.start); back != got {
+			t.Errorf("IsSameWeek(%v, %v, %v) is not symmetric", tt.b, tt.a, tt.start)
+		}
+	}
+
+	if _, err := (EtDate{Year: 2016, Month: 1, Day: 1}).IsSameWeek(EtDate{Year: 2016, Month: 13, Day: 6}, time.Sunday); err == nil {
+		t.Error("Expected error for an invalid date")
+	}
+}
+
+func TestGregorianRangeOfEthiopianWeek(t *testing.T) {
+	tests := []struct {
+		d          EtDate
+		start      time.Weekday
+		first, end GregorianDate
+	}{
+		// Meskerem 1, 2016 is Tuesday, 12 September 2023.
+		{EtDate{Year: 2016, Month: 1, Day: 1}, time.Sunday, GregorianDate{Year: 2023, Month: 9, Day: 10}, GregorianDate{Year: 2023, Month: 9, Day: 16}},
+		{EtDate{Year: 2016, Month: 1, Day: 1}, time.Tuesday, GregorianDate{Year: 2023, Month: 9, Day: 12}, GregorianDate{Year: 2023, Month: 9, Day: 18}},
+		// Meskerem 20, 2016 is Sunday, 1 October 2023.
+		{EtDate{Year: 2016, Month: 1, Day: 20}, time.Monday, GregorianDate{Year: 2023, Month: 9, Day: 25}, GregorianDate{Year: 2023, Month: 10, Day: 1}},
+		{EtDate{Year: 2016, Month: 4, Day: 21}, time.Monday, GregorianDate{Year: 2023, Month: 12, Day: 25}, GregorianDate{Year: 2023, Month: 12, Day: 31}},
+		{EtDate{Year: 2016, Month: 4, Day: 21}, time.Sunday, GregorianDate{Year: 2023, Month: 12, Day: 31}, GregorianDate{Year: 2024, Month: 1, Day: 6}},
+	}
+	for _, tt := range tests {
+		first, end, err := GregorianRangeOfEthiopianWeek(tt.d, tt.start)
+		if err != nil {
+			t.Fatalf("GregorianRangeOfEthiopianWeek(%v, %v) error: %v", tt.d, tt.start, err)
+		}
+		if first != tt.first || end != tt.end {
+			t.Errorf("GregorianRangeOfEthiopianWeek(%v, %v) = %v, %v, want %v, %v", tt.d, tt.start, first, end, tt.first, tt.end)
+		}
+	}
+
+	if _, _, err := GregorianRangeOfEthiopianWeek(EtDate{Year: 2016, Month: 13, Day: 6}, time.Sunday); err == nil {
+		t.Error("Expected error for an invalid date")
+	}
+}
+
+func TestNextAndPrevious(t *testing.T) {
+	// Meskerem 1, 2016 is a Tuesday.
+	tuesday := EtDate{Year: 2016, Month: 1, Day: 1}
+	tests := []struct {
+		d              EtDate
+		weekday        time.Weekday
+		next, previous EtDate
+	}{
+		{tuesday, time.Wednesday, EtDate{Year: 2016, Month: 1, Day: 2}, EtDate{Year: 2015, Month: 13, Day: 1}},
+		{tuesday, time.Monday, EtDate{Year: 2016, Month: 1, Day: 7}, EtDate{Year: 2015, Month: 13, Day: 6}},
+		// Same weekday jumps a full week.
+		{tuesday, time.Tuesday, EtDate{Year: 2016, Month: 1, Day: 8}, EtDate{Year: 2015, Month: 12, Day: 30}},
+		// Meskerem 30, 2016 is a Wednesday.
+		{EtDate{Year: 2016, Month: 1, Day: 30}, time.Friday, EtDate{Year: 2016, Month: 2, Day: 2}, EtDate{Year: 2016, Month: 1, Day: 25}},
+		{EtDate{Year: 2016, Month: 1, Day: 30}, time.Wednesday, EtDate{Year: 2016, Month: 2, Day: 7}, EtDate{Year: 2016, Month: 1, Day: 23}},
+	}
+	for _, tt := range tests {
+		next, err := tt.d.Next(tt.weekday)
+		if err != nil || next != tt.next {
+			t.Errorf("Next(%v, %v) = %v, %v, want %v", tt.d, tt.weekday, next, err, tt.next)
+		}
+		previous, err := tt.d.Previous(tt.weekday)
+		if err != nil || previous != tt.previous {
+			t.Errorf("Previous(%v, %v) = %v, %v, want %v", tt.d, tt.weekday, previous, err, tt.previous)
+		}
+		for _, got := range []EtDate{next, previous} {
+			if wd, _ := got.GregorianWeekday(); wd != tt.weekday {
+				t.Errorf("%v falls on %v, want %v", got, wd, tt.weekday)
+			}
+		}
+	}
+
+	if _, err := (EtDate{Year: 2016, Month: 13, Day: 6}).Next(time.Sunday); err == nil {
+		t.Error("Expected error for an invalid date")
+	}
+	if _, err := (EtDate{Year: 1, Month: 1, Day: 1}).Previous(time.Sunday); err == nil {
+		t.Error("Expected error before the epoch")
+	}
+}
+
+func TestWeekdayNames(t *testing.T) {
+	tests := []struct {
+		d                EtDate
+		english, amharic string
+	}{
+		{EtDate{Year: 2016, Month: 1, Day: 1}, "Tuesday", "ማክሰኞ"},
+		{EtDate{Year: 2016, Month: 1, Day: 2}, "Wednesday", "ረቡዕ"},
+		{EtDate{Year: 2016, Month: 1, Day: 3}, "Thursday", "ሐሙስ"},
+		{EtDate{Year: 2016, Month: 1, Day: 4}, "Friday", "ዓርብ"},
+		{EtDate{Year: 2016, Month: 1, Day: 5}, "Saturday", "ቅዳሜ"},
+		{EtDate{Year: 2016, Month: 1, Day: 6}, "Sunday", "እሑድ"},
+		{EtDate{Year: 2016, Month: 1, Day: 7}, "Monday", "ሰኞ"},
+	}
+	for _, tt := range tests {
+		for _, loc := range []struct{ locale, want string }{
+			{LocaleEnglish, tt.english},
+			{LocaleAmharic, tt.amharic},
+			{"fr", tt.english},
+		} {
+			got, err := tt.d.WeekdayNameLocale(loc.locale)
+			if err != nil || got != loc.want {
+				t.Errorf("WeekdayNameLocale(%v, %q) = %q, %v, want %q", tt.d, loc.locale, got, err, loc.want)
+			}
+		}
+
+		gy, gm, gd, _ := tt.d.ToGregorian()
+		tm := time.Date(gy, time.Month(gm), gd, 12, 0, 0, 0, time.UTC)
+		if got := GregorianWeekdayNameAmharic(tm); got != tt.amharic {
+			t.Errorf("GregorianWeekdayNameAmharic(%v) = %q, want %q", tm, got, tt.amharic)
+		}
+	}
+
+	if _, err := (EtDate{Year: 2016, Month: 13, Day: 6}).WeekdayNameLocale(LocaleEnglish); err == nil {
+		t.Error("Expected error for an invalid date")
+	}
+}
+
+func TestCountWeekday(t *testing.T) {
+	tests := []struct {
+		start, end EtDate
+		weekday    time.Weekday
+		want       int
+	}{
+		// A single Tuesday.
+		{EtDate{Year: 2016, Month: 1, Day: 1}, EtDate{Year: 2016, Month: 1, Day: 1}, time.Tuesday, 1},
+		{EtDate{Year: 2016, Month: 1, Day: 1}, EtDate{Year: 2016, Month: 1, Day: 1}, time.Saturday, 0},
+		// One full week has one of each weekday.
+		{EtDate{Year: 2016, Month: 1, Day: 1}, EtDate{Year: 2016, Month: 1, Day: 7}, time.Saturday, 1},
+		// Meskerem 2016: 30 days from a Tuesday.
+		{EtDate{Year: 2016, Month: 1, Day: 1}, EtDate{Year: 2016, Month: 1, Day: 30}, time.Tuesday, 5},
+		{EtDate{Year: 2016, Month: 1, Day: 1}, EtDate{Year: 2016, Month: 1, Day: 30}, time.Wednesday, 5},
+		{EtDate{Year: 2016, Month: 1, Day: 1}, EtDate{Year: 2016, Month: 1, Day: 30}, time.Thursday, 4},
+		{EtDate{Year: 2016, Month: 1, Day: 1}, EtDate{Year: 2016, Month: 1, Day: 30}, time.Saturday, 4},
+		// All of 2016 (365 days from a Tuesday) and 2015 (366 from a Sunday).
+		{EtDate{Year: 2016, Month: 1, Day: 1}, EtDate{Year: 2016, Month: 13, Day: 5}, time.Tuesday, 53},
+		{EtDate{Year: 2016, Month: 1, Day: 1}, EtDate{Year: 2016, Month: 13, Day: 5}, time.Saturday, 52},
+		{EtDate{Year: 2015, Month: 1, Day: 1}, EtDate{Year: 2015, Month: 13, Day: 6}, time.Sunday, 53},
+		{EtDate{Year: 2015, Month: 1, Day: 1}, EtDate{Year: 2015, Month: 13, Day: 6}, time.Monday, 53},
+		{EtDate{Year: 2015, Month: 1, Day: 1}, EtDate{Year: 2015, Month: 13, Day: 6}, time.Saturday, 52},
+	}
+	for _, tt := range tests {
+		got, err := CountWeekday(tt.start, tt.end, tt.weekday)
+		if err != nil {
+			t.Fatalf("CountWeekday(%v, %v, %v) error: %v", tt.start, tt.end, tt.weekday, err)
+		}
+		if got != tt.want {
+			t.Errorf("CountWeekday(%v, %v, %v) = %d, want %d", tt.start, tt.end, tt.weekday, got, tt.want)
+		}
+	}
+
+	// The count matches a day-by-day walk for spans of every length up to 40.
+	start := EtDate{Year: 2015, Month: 13, Day: 1}
+	for n := 1; n <= 40; n++ {
+		end, _ := start.AddDays(n - 1)
+		for wd := time.Sunday; wd <= time.Saturday; wd++ {
+			want := 0
+			for d := start; ; d, _ = d.AddDays(1) {
+				if got, _ := d.GregorianWeekday(); got == wd {
+					want++
+				}
+				if d == end {
+					break
+				}
+			}
+			if got, _ := CountWeekday(start, end, wd); got != want {
+				t.Errorf("CountWeekday(%v, %v, %v) = %d, want %d", start, end, wd, got, want)
+			}
+		}
+	}
+
+	if _, err := CountWeekday(EtDate{Year: 2016, Month: 1, Day: 2}, EtDate{Year: 2016, Month: 1, Day: 1}, time.Sunday); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("CountWeekday of reversed range error = %v, want ErrInvalidRange", err)
+	}
+}
+
+func TestStartOfWeek(t *testing.T) {
+	tests := []struct {
+		d     EtDate
+		start time.Weekday
+		want  EtDate
+	}{
+		// Meskerem 1, 2016 is a Tuesday.
+		{EtDate{Year: 2016, Month: 1, Day: 1}, time.Tuesday, EtDate{Year: 2016, Month: 1, Day: 1}},
+		{EtDate{Year: 2016, Month: 1, Day: 1}, time.Monday, EtDate{Year: 2015, Month: 13, Day: 6}},
+		{EtDate{Year: 2016, Month: 1, Day: 1}, time.Sunday, EtDate{Year: 2015, Month: 13, Day: 5}},
+		{EtDate{Year: 2016, Month: 1, Day: 1}, time.Wednesday, EtDate{Year: 2015, Month: 13, Day: 1}},
+		// Tikimt 2, 2016 is a Friday.
+		{EtDate{Year: 2016, Month: 2, Day: 2}, time.Monday, EtDate{Year: 2016, Month: 1, Day: 28}},
+		{EtDate{Year: 2016, Month: 2, Day: 2}, time.Friday, EtDate{Year: 2016, Month: 2, Day: 2}},
+		{EtDate{Year: 2016, Month: 2, Day: 2}, time.Saturday, EtDate{Year: 2016, Month: 1, Day: 26}},
+	}
+	for _, tt := range tests {
+		got, err := tt.d.StartOfWeek(tt.start)
+		if err != nil || got != tt.want {
+			t.Errorf("StartOfWeek(%v, %v) = %v, %v, want %v", tt.d, tt.start, got, err, tt.want)
+		}
+	}
+
+	if _, err := (EtDate{Year: 2016, Month: 13, Day: 6}).StartOfWeek(time.Sunday); err == nil {
+		t.Error("Expected error for an invalid date")
+	}
+}
+
+func TestWeekdayDatesInMonth(t *testing.T) {
+	// Tir 1, 2016 is a Wednesday.
+	got, err := WeekdayDatesInMonth(2016, 5, time.Monday)
+	if err != nil {
+		t.Fatalf("WeekdayDatesInMonth error: %v", err)
+	}
+	want := []EtDate{{Year: 2016, Month: 5, Day: 6}, {Year: 2016, Month: 5, Day: 13}, {Year: 2016, Month: 5, Day: 20}, {Year: 2016, Month: 5, Day: 27}}
+	if !slices.Equal(got, want) {
+		t.Errorf("WeekdayDatesInMonth(2016, Tir, Monday) = %v, want %v", got, want)
+	}
+	for i, d := range got {
+		if wd, _ := d.GregorianWeekday(); wd != time.Monday {
+			t.Errorf("%v falls on %v, want Monday", d, wd)
+		}
+		if i > 0 {
+			if gap, _ := Between(got[i-1], d); gap != 7 {
+				t.Errorf("%v and %v are %d days apart, want 7", got[i-1], d, gap)
+			}
+		}
+	}
+
+	// Wednesday 2015-13-01 to Monday 2015-13-06 has no Tuesday.
+	if got, err := WeekdayDatesInMonth(2015, 13, time.Tuesday); err != nil || len(got) != 0 {
+		t.Errorf("WeekdayDatesInMonth(2015, Pagume, Tuesday) = %v, %v, want none", got, err)
+	}
+	if _, err := WeekdayDatesInMonth(2016, 14, time.Monday); !errors.Is(err, ErrInvalidMonth) {
+		t.Errorf("WeekdayDatesInMonth(2016, 14) error = %v, want ErrInvalidMonth", err)
+	}
+}
+
+func TestWeekday(t *testing.T) {
+	tests := []struct {
+		d    EtDate
+		want int
+		name string
+	}{
+		{EtDate{Year: 1, Month: 1, Day: 1}, 3, "Rob"}, // the epoch
+		{EtDate{Year: 2016, Month: 1, Day: 1}, 2, "Maksegno"},
+		{EtDate{Year: 2016, Month: 1, Day: 6}, 0, "Ehud"},
+		{EtDate{Year: 2016, Month: 1, Day: 7}, 1, "Segno"},
+		{EtDate{Year: 2016, Month: 1, Day: 5}, 6, "Kidame"},
+		{EtDate{Year: 2015, Month: 13, Day: 6}, 1, "Segno"},
+	}
+	for _, tt := range tests {
+		got, err := tt.d.Weekday()
+		if err != nil {
+			t.Fatalf("Weekday(%v) error: %v", tt.d, err)
+		}
+		if got != tt.want || WeekdayNames[got] != tt.name {
+			t.Errorf("Weekday(%v) = %d (%s), want %d (%s)", tt.d, got, WeekdayNames[got], tt.want, tt.name)
+		}
+		if wd, _ := tt.d.GregorianWeekday(); int(wd) != got {
+			t.Errorf("Weekday(%v) = %d but GregorianWeekday = %v", tt.d, got, wd)
+		}
+	}
+
+	if _, err := (EtDate{Year: 2016, Month: 13, Day: 6}).Weekday(); !errors.Is(err, ErrInvalidDay) {
+		t.Errorf("Weekday(2016-13-06) error = %v, want ErrInvalidDay", err)
+	}
+}
+
+func TestWeekOfYear(t *testing.T) {
+	// 2016 starts on a Tuesday and is a common year.
+	tests := []struct {
+		date        EtDate
+		startOfWeek time.Weekday
+		want        int
+	}{
+		{EtDate{Year: 2016, Month: 1, Day: 1}, time.Sunday, 1},
+		{EtDate{Year: 2016, Month: 1, Day: 5}, time.Sunday, 1},
+		{EtDate{Year: 2016, Month: 1, Day: 6}, time.Sunday, 2},
+		{EtDate{Year: 2016, Month: 1, Day: 6}, time.Monday, 1},
+		{EtDate{Year: 2016, Month: 1, Day: 7}, time.Monday, 2},
+		{EtDate{Year: 2016, Month: 13, Day: 5}, time.Sunday, 53},
+		{EtDate{Year: 2016, Month: 13, Day: 5}, time.Monday, 53},
+	}
+	for _, tt := range tests {
+		if got, err := tt.date.WeekOfYear(tt.startOfWeek); err != nil || got != tt.want {
+			t.Errorf("%v.WeekOfYear(%v) = %d, %v, want %d", tt.date, tt.startOfWeek, got, err, tt.want)
+		}
+	}
+
+	d := EtDate{Year: 2016, Month: 1, Day: 6}
+	if got, _ := d.WeekOfYear(); got != 2 {
+		t.Errorf("%v.WeekOfYear() = %d, want 2 with the default Sunday start", d, got)
+	}
+	if got, _ := NewCalendar(time.Monday, LocaleEnglish).WeekOfYear(d); got != 1 {
+		t.Errorf("Calendar.WeekOfYear(%v) = %d, want 1 with a Monday start", d, got)
+	}
+	if _, err := (EtDate{Year: 2016, Month: 14, Day: 1}).WeekOfYear(); err == nil {
+		t.Error("Expected error for an invalid date")
+	}
+}
+
+func TestFirstWeekdayOfMonth(t *testing.T) {
+	tests := []struct {
+		year, month int
+		startOfWeek time.Weekday
+		want        int
+	}{
+		// Meskerem 1, 2016 is a Tuesday and Pagume 1, 2016 a Friday.
+		{2016, 1, time.Sunday, 2},
+		{2016, 1, time.Monday, 1},
+		{2016, 1, time.Tuesday, 0},
+		{2016, 13, time.Sunday, 5},
+		{2016, 13, time.Monday, 4},
+	}
+	for _, tt := range tests {
+		if got, err := FirstWeekdayOfMonth(tt.year, tt.month, tt.startOfWeek); err != nil || got != tt.want {
+			t.Errorf("FirstWeekdayOfMonth(%d, %d, %v) = %d, %v, want %d", tt.year, tt.month, tt.startOfWeek, got, err, tt.want)
+		}
+	}
+
+	defer func(start time.Weekday) { DefaultCalendar.WeekStart = start }(DefaultCalendar.WeekStart)
+	DefaultCalendar.WeekStart = time.Monday
+	if got, _ := FirstWeekdayOfMonth(2016, 1); got != 1 {
+		t.Errorf("FirstWeekdayOfMonth(2016, 1) with a Monday default = %d, want 1", got)
+	}
+	if _, err := FirstWeekdayOfMonth(0, 1); !errors.Is(err, ErrInvalidYear) {
+		t.Errorf("FirstWeekdayOfMonth(0, 1) error = %v, want ErrInvalidYear", err)
+	}
+}
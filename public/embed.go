@@ -0,0 +1,10 @@
+// Package public holds the web UI served by the API at "/".
+package public
+
+import _ "embed"
+
+// IndexHTML is the contents of index.html, embedded so the server can
+// serve the UI whatever its working directory.
+//
+//go:embed index.html
+var IndexHTML []byte